@@ -34,17 +34,21 @@ func main() {
 	defer client.Logout(ctx)
 
 	// List all sites
-	sites, err := client.ListSites(ctx)
+	sites, err := client.ListSites(ctx, nil)
 	if err != nil {
 		log.Fatalf("Failed to list sites: %v", err)
 	}
-	fmt.Printf("Found %d sites\n", len(sites))
-	for _, site := range sites {
-		fmt.Printf("  - %s (%s)\n", site.Desc, site.Name)
+	fmt.Printf("Found %d sites\n", sites.TotalCount)
+	for _, site := range sites.Data {
+		fmt.Printf("  - %s (%s)\n", site.Name, site.ID)
 	}
+	if len(sites.Data) == 0 {
+		return
+	}
+	siteID := sites.Data[0].ID
 
-	// Get site health
-	health, err := client.GetSiteHealth(ctx)
+	// Get site health from the classic API of the configured site
+	health, err := client.Legacy().GetSiteHealth(ctx)
 	if err != nil {
 		log.Fatalf("Failed to get site health: %v", err)
 	}
@@ -53,29 +57,29 @@ func main() {
 	}
 
 	// List all devices
-	devices, err := client.ListDevices(ctx)
+	devices, err := client.ListDevices(ctx, &network.ListAdoptedDevicesRequest{SiteID: siteID})
 	if err != nil {
 		log.Fatalf("Failed to list devices: %v", err)
 	}
-	fmt.Printf("\nFound %d devices\n", len(devices))
-	for _, device := range devices {
-		fmt.Printf("  - %s (%s) - %s\n", device.Name, device.Model, device.IP)
+	fmt.Printf("\nFound %d devices\n", devices.TotalCount)
+	for _, device := range devices.Data {
+		fmt.Printf("  - %s (%s) - %s\n", device.Name, device.Model, device.IPAddress)
 	}
 
 	// List all clients
-	clients, err := client.ListClients(ctx)
+	clients, err := client.ListClients(ctx, &network.ConnectedClientsRequest{SiteID: siteID})
 	if err != nil {
 		log.Fatalf("Failed to list clients: %v", err)
 	}
-	fmt.Printf("\nFound %d connected clients\n", len(clients))
+	fmt.Printf("\nFound %d connected clients\n", clients.TotalCount)
 
 	// List WLANs
-	wlans, err := client.ListWLANs(ctx)
+	wlans, err := client.ListWLANs(ctx, &network.ListWLANsRequest{SiteID: siteID})
 	if err != nil {
 		log.Fatalf("Failed to list WLANs: %v", err)
 	}
-	fmt.Printf("\nFound %d WLANs\n", len(wlans))
-	for _, wlan := range wlans {
+	fmt.Printf("\nFound %d WLANs\n", wlans.TotalCount)
+	for _, wlan := range wlans.Data {
 		status := "disabled"
 		if wlan.Enabled {
 			status = "enabled"
@@ -7,27 +7,39 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"time"
 
+	"github.com/ilmax/unifi-client-go/internal/logging"
 	"github.com/ilmax/unifi-client-go/pkg/config"
 	"github.com/ilmax/unifi-client-go/pkg/errors"
+	"github.com/ilmax/unifi-client-go/pkg/middleware"
 )
 
 // Client is the HTTP client for UniFi APIs.
 type Client struct {
 	httpClient *http.Client
+	doer       middleware.Doer
 	baseURL    string
 	apiKey     string
 	userAgent  string
+	retry      config.RetryPolicy
+	limiter    *rateLimiter
+	logger     *slog.Logger
 }
 
 // NewClient creates a new HTTP client from config.
 func NewClient(cfg config.Config) Client {
 	return Client{
 		httpClient: cfg.HTTPClient,
+		doer:       middleware.Chain(cfg.HTTPClient, cfg.Middlewares...),
 		baseURL:    cfg.BaseURL,
 		apiKey:     cfg.APIKey,
 		userAgent:  cfg.UserAgent,
+		retry:      cfg.Retry,
+		limiter:    newRateLimiter(cfg.RateLimit),
+		logger:     cfg.Logger,
 	}
 }
 
@@ -52,20 +64,59 @@ func (c *Client) Delete(ctx context.Context, path string, result interface{}) er
 }
 
 func (c *Client) do(ctx context.Context, method, path string, body, result interface{}) error {
-	url := c.baseURL + path
-
-	var bodyReader io.Reader
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return fmt.Errorf("failed to marshal request body: %w", err)
 		}
+	}
+
+	attempts := maxAttempts(c.retry, method)
+	for attempt := 1; ; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.wait(ctx); err != nil {
+				return withAttempts(err, attempt-1)
+			}
+		}
+
+		respBody, header, err := c.send(ctx, method, path, jsonBody, attempt)
+		if c.limiter != nil && header != nil {
+			c.limiter.observe(header, time.Now())
+		}
+		if err == nil {
+			if result != nil && len(respBody) > 0 {
+				if err := json.Unmarshal(respBody, result); err != nil {
+					return fmt.Errorf("failed to decode response: %w", err)
+				}
+			}
+			return nil
+		}
+
+		if attempt >= attempts || !isRetryable(err) {
+			return withAttempts(err, attempt)
+		}
+		if err := sleep(ctx, retryDelay(c.retry, attempt, parseRetryAfter(header, time.Now()))); err != nil {
+			return withAttempts(err, attempt)
+		}
+	}
+}
+
+// send makes a single attempt and returns the response body and headers.
+// Responses with a status of 400 or above are returned as *errors.APIError.
+// The attempt is logged at debug level when a logger is configured.
+func (c *Client) send(ctx context.Context, method, path string, jsonBody []byte, attempt int) ([]byte, http.Header, error) {
+	url := c.baseURL + path
+
+	var bodyReader io.Reader
+	if jsonBody != nil {
 		bodyReader = bytes.NewReader(jsonBody)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("X-API-Key", c.apiKey)
@@ -73,32 +124,50 @@ func (c *Client) do(ctx context.Context, method, path string, body, result inter
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", c.userAgent)
 
-	resp, err := c.httpClient.Do(req)
+	start := time.Now()
+	entry := logging.Request{Method: method, Path: path, Header: req.Header, Body: jsonBody, Attempt: attempt}
+	defer func() {
+		entry.Duration = time.Since(start)
+		logging.LogRequest(ctx, c.logger, entry)
+	}()
+
+	resp, err := c.doer.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to send request: %w", err)
+		entry.Err = err
+		return nil, nil, fmt.Errorf("failed to send request: %w", err)
 	}
 	defer resp.Body.Close()
 
+	entry.Status = resp.StatusCode
+	entry.RequestID = resp.Header.Get("X-Request-Id")
+
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		entry.Err = err
+		return nil, resp.Header, fmt.Errorf("failed to read response body: %w", err)
 	}
 
 	if resp.StatusCode >= 400 {
-		return errors.NewAPIError(
+		return nil, resp.Header, errors.NewAPIError(
 			resp.StatusCode,
 			string(respBody),
 			resp.Header.Get("X-Request-Id"),
 		)
 	}
 
-	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
-			return fmt.Errorf("failed to decode response: %w", err)
-		}
-	}
+	return respBody, resp.Header, nil
+}
 
-	return nil
+// withAttempts records the number of attempts on an error returned after retrying.
+func withAttempts(err error, attempts int) error {
+	if attempts < 2 {
+		return err
+	}
+	if apiErr, ok := err.(*errors.APIError); ok {
+		apiErr.Attempts = attempts
+		return apiErr
+	}
+	return fmt.Errorf("%w (after %d attempts)", err, attempts)
 }
 
 // SetBaseURL sets the base URL.
@@ -2,17 +2,22 @@
 package http
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	pkgerrors "github.com/ilmax/unifi-client-go/pkg/errors"
 
 	"github.com/ilmax/unifi-client-go/pkg/config"
+	"github.com/ilmax/unifi-client-go/pkg/middleware"
 )
 
 // TestNewClient tests the NewClient function.
@@ -615,6 +620,94 @@ func TestClient_UserAgentHeader(t *testing.T) {
 	}
 }
 
+// TestClient_Middlewares tests that middlewares wrap every attempt.
+func TestClient_Middlewares(t *testing.T) {
+	t.Parallel()
+
+	var receivedTrace []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedTrace = append(receivedTrace, r.Header.Get("X-Trace-Id"))
+		if len(receivedTrace) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var statuses []int
+	record := func(next middleware.Doer) middleware.Doer {
+		return middleware.DoerFunc(func(req *http.Request) (*http.Response, error) {
+			resp, err := next.Do(req)
+			if err == nil {
+				statuses = append(statuses, resp.StatusCode)
+			}
+			return resp, err
+		})
+	}
+
+	client := NewClient(config.Config{
+		BaseURL:     server.URL,
+		HTTPClient:  server.Client(),
+		Retry:       config.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+		Middlewares: []middleware.Middleware{record, middleware.Headers(http.Header{"X-Trace-Id": {"trace-1"}})},
+	})
+
+	if err := client.Get(context.Background(), "/test", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(statuses) != 2 || statuses[0] != http.StatusServiceUnavailable || statuses[1] != http.StatusOK {
+		t.Errorf("statuses = %v", statuses)
+	}
+	if len(receivedTrace) != 2 || receivedTrace[1] != "trace-1" {
+		t.Errorf("X-Trace-Id = %v", receivedTrace)
+	}
+}
+
+// TestClient_Logger tests that every attempt is logged with the API key redacted.
+func TestClient_Logger(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("X-Request-Id", fmt.Sprintf("req-%d", calls))
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := NewClient(config.Config{
+		BaseURL:    server.URL,
+		APIKey:     "secret-key",
+		HTTPClient: server.Client(),
+		Retry:      config.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+		Logger:     slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+	})
+
+	if err := client.Get(context.Background(), "/v1/hosts", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("logged %d records, want 2:\n%s", len(lines), buf.String())
+	}
+	if strings.Contains(buf.String(), "secret-key") {
+		t.Errorf("logs leak the API key:\n%s", buf.String())
+	}
+	for i, want := range []string{`"status":503,`, `"status":200,`} {
+		if !strings.Contains(lines[i], want) || !strings.Contains(lines[i], fmt.Sprintf(`"request_id":"req-%d"`, i+1)) {
+			t.Errorf("record %d = %s", i, lines[i])
+		}
+	}
+	if !strings.Contains(lines[1], `"attempt":2`) {
+		t.Errorf("retry record = %s, want attempt 2", lines[1])
+	}
+}
+
 // TestClient_JSONParsingError tests error handling for invalid JSON responses.
 func TestClient_JSONParsingError(t *testing.T) {
 	t.Parallel()
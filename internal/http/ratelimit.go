@@ -0,0 +1,110 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ilmax/unifi-client-go/pkg/config"
+)
+
+// rateLimiter is a token bucket shared by every request of a client.
+// It also pauses all requests when the server reports an exhausted quota.
+type rateLimiter struct {
+	mu          sync.Mutex
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+// newRateLimiter returns a limiter for the given settings, or nil if rate limiting is disabled.
+func newRateLimiter(cfg config.RateLimit) *rateLimiter {
+	if cfg.RequestsPerSecond <= 0 {
+		return nil
+	}
+	burst := float64(max(cfg.Burst, 1))
+	return &rateLimiter{
+		rate:   cfg.RequestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// wait blocks until a request may be sent or ctx is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		delay := l.reserve(time.Now())
+		if delay == 0 {
+			return nil
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token and returns 0, or returns how long to wait before trying again.
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(now)
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+func (l *rateLimiter) refill(now time.Time) {
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens = min(l.burst, l.tokens+elapsed.Seconds()*l.rate)
+		l.last = now
+	}
+}
+
+// observe adapts the limiter to the quota reported by a response.
+// X-RateLimit-Remaining caps the available tokens; an exhausted quota pauses requests until
+// X-RateLimit-Reset, and Retry-After pauses them for the given time.
+func (l *rateLimiter) observe(header http.Header, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(now)
+
+	if remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining")); err == nil {
+		l.tokens = min(l.tokens, float64(max(remaining, 0)))
+		if remaining <= 0 {
+			if reset := parseRateLimitReset(header.Get("X-RateLimit-Reset"), now); reset.After(l.pausedUntil) {
+				l.pausedUntil = reset
+			}
+		}
+	}
+
+	if retryAfter := parseRetryAfter(header, now); retryAfter > 0 {
+		if until := now.Add(retryAfter); until.After(l.pausedUntil) {
+			l.pausedUntil = until
+		}
+	}
+}
+
+// parseRateLimitReset parses X-RateLimit-Reset, given either as seconds until the reset or as a Unix timestamp.
+func parseRateLimitReset(value string, now time.Time) time.Time {
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seconds <= 0 {
+		return time.Time{}
+	}
+	// Values this large cannot be a delay, so they are absolute timestamps.
+	if seconds > 1_000_000_000 {
+		return time.Unix(seconds, 0)
+	}
+	return now.Add(time.Duration(seconds) * time.Second)
+}
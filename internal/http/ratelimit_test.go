@@ -0,0 +1,106 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ilmax/unifi-client-go/pkg/config"
+)
+
+// TestRateLimiter tests the token bucket and its adaptation to quota headers.
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	if newRateLimiter(config.RateLimit{}) != nil {
+		t.Error("newRateLimiter() with zero rate should be nil")
+	}
+
+	start := time.Now()
+	l := newRateLimiter(config.RateLimit{RequestsPerSecond: 10, Burst: 2})
+	l.last = start
+
+	for i := range 2 {
+		if delay := l.reserve(start); delay != 0 {
+			t.Fatalf("reserve() %d = %v, want 0 within burst", i, delay)
+		}
+	}
+	if delay := l.reserve(start); delay != 100*time.Millisecond {
+		t.Errorf("reserve() after burst = %v, want 100ms", delay)
+	}
+	if delay := l.reserve(start.Add(100 * time.Millisecond)); delay != 0 {
+		t.Errorf("reserve() after refill = %v, want 0", delay)
+	}
+
+	now := start.Add(time.Second)
+	l.observe(http.Header{"X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Reset": {"3"}}, now)
+	if delay := l.reserve(now); delay != 3*time.Second {
+		t.Errorf("reserve() with exhausted quota = %v, want 3s", delay)
+	}
+
+	now = now.Add(3 * time.Second)
+	l.observe(http.Header{"Retry-After": {"5"}}, now)
+	if delay := l.reserve(now.Add(time.Second)); delay != 4*time.Second {
+		t.Errorf("reserve() after Retry-After = %v, want 4s", delay)
+	}
+
+	now = now.Add(5 * time.Second)
+	l.observe(http.Header{"X-Ratelimit-Remaining": {"1"}}, now)
+	if l.tokens != 1 {
+		t.Errorf("tokens = %v, want capped to 1 by X-RateLimit-Remaining", l.tokens)
+	}
+}
+
+// TestParseRateLimitReset tests relative and absolute reset values.
+func TestParseRateLimitReset(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"":           {},
+		"soon":       {},
+		"30":         now.Add(30 * time.Second),
+		"1735689660": time.Unix(1735689660, 0),
+	}
+	for value, want := range tests {
+		if got := parseRateLimitReset(value, now); !got.Equal(want) {
+			t.Errorf("parseRateLimitReset(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
+
+// TestClient_RateLimit tests that copies of a client share one limiter.
+func TestClient_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(config.Config{
+		BaseURL:    server.URL,
+		HTTPClient: server.Client(),
+		RateLimit:  config.RateLimit{RequestsPerSecond: 0.01, Burst: 1},
+	})
+	clone := client
+
+	if err := client.Get(context.Background(), "/test", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := clone.Get(ctx, "/test", nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v, want context.DeadlineExceeded while waiting for a token", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("calls = %d, want 1", calls.Load())
+	}
+}
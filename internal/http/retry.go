@@ -0,0 +1,107 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/ilmax/unifi-client-go/pkg/config"
+	pkgerrors "github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// maxAttempts returns the number of attempts allowed for a request method.
+func maxAttempts(policy config.RetryPolicy, method string) int {
+	if policy.MaxAttempts < 2 {
+		return 1
+	}
+	if method == http.MethodPost && !policy.RetryPOST {
+		return 1
+	}
+	return policy.MaxAttempts
+}
+
+// isRetryable reports whether a failed attempt may succeed if repeated.
+func isRetryable(err error) bool {
+	var apiErr *pkgerrors.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	return isTransientNetworkError(err)
+}
+
+// isTransientNetworkError reports whether err is a timeout or a dropped connection, as opposed to a
+// cancelled context or a permanent failure such as an invalid URL or a TLS error.
+func isTransientNetworkError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// retryDelay returns how long to wait before the retry following the given attempt (1-based).
+// The exponential delay is jittered between half and all of its value; a longer Retry-After wins.
+func retryDelay(policy config.RetryPolicy, attempt int, retryAfter time.Duration) time.Duration {
+	base, ceiling := policy.BaseDelay, policy.MaxDelay
+	if base <= 0 {
+		base = config.DefaultRetryBaseDelay
+	}
+	if ceiling <= 0 {
+		ceiling = config.DefaultRetryMaxDelay
+	}
+
+	delay := base
+	for i := 1; i < attempt && delay < ceiling; i++ {
+		delay *= 2
+	}
+	delay = min(delay, ceiling)
+	delay = delay/2 + rand.N(delay/2+1)
+
+	return max(delay, retryAfter)
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date.
+func parseRetryAfter(header http.Header, now time.Time) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0)
+	}
+	return 0
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
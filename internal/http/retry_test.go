@@ -0,0 +1,193 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ilmax/unifi-client-go/pkg/config"
+	pkgerrors "github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// TestClient_Retry tests retrying failed requests.
+func TestClient_Retry(t *testing.T) {
+	t.Parallel()
+
+	policy := config.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+
+	tests := []struct {
+		name         string
+		method       string
+		policy       config.RetryPolicy
+		statuses     []int
+		wantCalls    int32
+		wantStatus   int
+		wantAttempts int
+	}{
+		{
+			name:      "retries until success",
+			method:    http.MethodGet,
+			policy:    policy,
+			statuses:  []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			wantCalls: 3,
+		},
+		{
+			name:         "gives up after max attempts",
+			method:       http.MethodPut,
+			policy:       policy,
+			statuses:     []int{http.StatusBadGateway, http.StatusGatewayTimeout, http.StatusServiceUnavailable},
+			wantCalls:    3,
+			wantStatus:   http.StatusServiceUnavailable,
+			wantAttempts: 3,
+		},
+		{
+			name:       "does not retry client errors",
+			method:     http.MethodGet,
+			policy:     policy,
+			statuses:   []int{http.StatusNotFound},
+			wantCalls:  1,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "does not retry POST by default",
+			method:     http.MethodPost,
+			policy:     policy,
+			statuses:   []int{http.StatusServiceUnavailable},
+			wantCalls:  1,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:      "retries POST when opted in",
+			method:    http.MethodPost,
+			policy:    config.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, RetryPOST: true},
+			statuses:  []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantCalls: 2,
+		},
+		{
+			name:       "disabled by default",
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusServiceUnavailable},
+			wantCalls:  1,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := calls.Add(1)
+				if body := readBody(r); r.Method != http.MethodGet && body != `{"a":1}` {
+					t.Errorf("attempt %d body = %q", n, body)
+				}
+				w.WriteHeader(tt.statuses[n-1])
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			client := NewClient(config.Config{BaseURL: server.URL, HTTPClient: server.Client(), Retry: tt.policy})
+
+			var body interface{}
+			if tt.method != http.MethodGet {
+				body = map[string]int{"a": 1}
+			}
+			err := client.do(context.Background(), tt.method, "/test", body, nil)
+
+			if calls.Load() != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls.Load(), tt.wantCalls)
+			}
+			if tt.wantStatus == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var apiErr *pkgerrors.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected APIError, got %v", err)
+			}
+			if apiErr.StatusCode != tt.wantStatus || apiErr.Attempts != tt.wantAttempts {
+				t.Errorf("APIError = %+v", apiErr)
+			}
+		})
+	}
+}
+
+// TestClient_RetryNetworkError tests retrying dropped connections.
+func TestClient_RetryNetworkError(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			conn, _, err := http.NewResponseController(w).Hijack()
+			if err == nil {
+				conn.Close()
+			}
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(config.Config{
+		BaseURL:    server.URL,
+		HTTPClient: server.Client(),
+		Retry:      config.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+	})
+
+	var result struct{ OK bool }
+	if err := client.Get(context.Background(), "/test", &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.OK || calls.Load() != 2 {
+		t.Errorf("result = %+v, calls = %d", result, calls.Load())
+	}
+}
+
+// TestRetryDelay tests the backoff and Retry-After handling.
+func TestRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	policy := config.RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	for attempt, want := range map[int]time.Duration{1: 100 * time.Millisecond, 2: 200 * time.Millisecond, 3: 400 * time.Millisecond, 10: time.Second} {
+		got := retryDelay(policy, attempt, 0)
+		if got < want/2 || got > want {
+			t.Errorf("retryDelay(attempt %d) = %v, want between %v and %v", attempt, got, want/2, want)
+		}
+	}
+	if got := retryDelay(policy, 1, 5*time.Second); got != 5*time.Second {
+		t.Errorf("retryDelay() with Retry-After = %v, want 5s", got)
+	}
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := map[string]time.Duration{
+		"":                              0,
+		"7":                             7 * time.Second,
+		"Wed, 01 Jan 2025 00:00:30 GMT": 30 * time.Second,
+		"soon":                          0,
+	}
+	for value, want := range tests {
+		header := http.Header{}
+		if value != "" {
+			header.Set("Retry-After", value)
+		}
+		if got := parseRetryAfter(header, now); got != want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
+
+func readBody(r *http.Request) string {
+	body, _ := io.ReadAll(r.Body)
+	return string(body)
+}
@@ -0,0 +1,143 @@
+// Package logging provides the debug request logging shared by the UniFi SDK clients.
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Redacted replaces secrets in logged headers and bodies.
+const Redacted = "[REDACTED]"
+
+// maxBodyLength is the number of bytes of a request body included in a log record.
+const maxBodyLength = 4096
+
+// Request describes a completed HTTP request attempt.
+type Request struct {
+	Method    string
+	Path      string
+	Header    http.Header
+	Body      []byte
+	Status    int
+	Duration  time.Duration
+	RequestID string
+	// Attempt is the 1-based attempt number when the request is retried.
+	Attempt int
+	Err     error
+}
+
+// LogRequest logs a request at debug level. Secrets in its headers and body are always redacted.
+// It does nothing if logger is nil or debug logging is disabled.
+func LogRequest(ctx context.Context, logger *slog.Logger, r Request) {
+	if logger == nil || !logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", r.Method),
+		slog.String("path", r.Path),
+	}
+	if r.Status != 0 {
+		attrs = append(attrs, slog.Int("status", r.Status))
+	}
+	attrs = append(attrs, slog.Duration("duration", r.Duration))
+	if r.RequestID != "" {
+		attrs = append(attrs, slog.String("request_id", r.RequestID))
+	}
+	if r.Attempt > 1 {
+		attrs = append(attrs, slog.Int("attempt", r.Attempt))
+	}
+	if len(r.Header) > 0 {
+		attrs = append(attrs, headerGroup(r.Header))
+	}
+	if len(r.Body) > 0 {
+		attrs = append(attrs, slog.String("body", RedactBody(r.Body)))
+	}
+	if r.Err != nil {
+		attrs = append(attrs, slog.String("error", r.Err.Error()))
+	}
+
+	logger.LogAttrs(ctx, slog.LevelDebug, "unifi request", attrs...)
+}
+
+func headerGroup(header http.Header) slog.Attr {
+	redacted := RedactHeader(header)
+	attrs := make([]any, 0, len(redacted))
+	for key, values := range redacted {
+		attrs = append(attrs, slog.String(key, strings.Join(values, ", ")))
+	}
+	return slog.Group("headers", attrs...)
+}
+
+// RedactHeader returns a copy of header with credentials, cookies and CSRF tokens redacted.
+func RedactHeader(header http.Header) http.Header {
+	redacted := make(http.Header, len(header))
+	for key, values := range header {
+		if isSecretHeader(key) {
+			redacted[key] = []string{Redacted}
+			continue
+		}
+		redacted[key] = append([]string(nil), values...)
+	}
+	return redacted
+}
+
+func isSecretHeader(key string) bool {
+	switch http.CanonicalHeaderKey(key) {
+	case "X-Api-Key", "Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie":
+		return true
+	}
+	return strings.Contains(strings.ToLower(key), "csrf")
+}
+
+// RedactBody returns body as a string with the values of secret JSON fields, such as passwords,
+// passphrases and tokens, redacted. Bodies that are not JSON are replaced by their length,
+// and long bodies are truncated.
+func RedactBody(body []byte) string {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return fmt.Sprintf("<%d bytes>", len(body))
+	}
+
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes>", len(body))
+	}
+	if len(redacted) > maxBodyLength {
+		return string(redacted[:maxBodyLength]) + "...(truncated)"
+	}
+	return string(redacted)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if isSecretField(key) {
+				v[key] = Redacted
+			} else {
+				v[key] = redactValue(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
+
+func isSecretField(key string) bool {
+	key = strings.ToLower(key)
+	for _, secret := range []string{"password", "passphrase", "secret", "token", "api_key", "apikey"} {
+		if strings.Contains(key, secret) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestRedactHeader tests that credentials, cookies and CSRF tokens are redacted.
+func TestRedactHeader(t *testing.T) {
+	t.Parallel()
+
+	header := http.Header{
+		"X-Api-Key":            {"secret"},
+		"Cookie":               {"TOKEN=secret"},
+		"X-Csrf-Token":         {"secret"},
+		"Authorization":        {"Bearer secret"},
+		"Accept":               {"application/json"},
+		"X-Updated-Csrf-Token": {"secret"},
+		"Content-Type":         {"application/json"},
+		"X-Custom-Header":      {"value"},
+	}
+	header["X-API-KEY"] = []string{"secret"}
+
+	redacted := RedactHeader(header)
+	for key, values := range redacted {
+		for _, value := range values {
+			if strings.Contains(value, "secret") {
+				t.Errorf("header %s = %q, want redacted", key, value)
+			}
+		}
+	}
+	if redacted.Get("Accept") != "application/json" || redacted.Get("X-Custom-Header") != "value" {
+		t.Errorf("RedactHeader() = %v, want other headers kept", redacted)
+	}
+	if header.Get("X-Api-Key") != "secret" {
+		t.Error("RedactHeader() modified the original header")
+	}
+}
+
+// TestRedactBody tests that secret JSON fields are redacted.
+func TestRedactBody(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "login",
+			body: `{"username":"admin","password":"secret","remember":true}`,
+			want: `{"password":"[REDACTED]","remember":true,"username":"admin"}`,
+		},
+		{
+			name: "nested passphrase",
+			body: `[{"name":"Home","x_passphrase":"secret","security":{"radiusSecret":"secret"}}]`,
+			want: `[{"name":"Home","security":{"radiusSecret":"[REDACTED]"},"x_passphrase":"[REDACTED]"}]`,
+		},
+		{
+			name: "not JSON",
+			body: `password=secret`,
+			want: `<15 bytes>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := RedactBody([]byte(tt.body)); got != tt.want {
+				t.Errorf("RedactBody() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestLogRequest tests the attributes of a logged request.
+func TestLogRequest(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	LogRequest(context.Background(), logger, Request{
+		Method:    http.MethodPost,
+		Path:      "/api/auth/login",
+		Header:    http.Header{"X-Api-Key": {"secret"}, "Accept": {"application/json"}},
+		Body:      []byte(`{"username":"admin","password":"secret"}`),
+		Status:    http.StatusServiceUnavailable,
+		Duration:  150 * time.Millisecond,
+		RequestID: "req-1",
+		Attempt:   2,
+		Err:       errors.New("unavailable"),
+	})
+
+	if strings.Contains(buf.String(), "secret") {
+		t.Fatalf("log leaks a secret: %s", buf.String())
+	}
+
+	var record struct {
+		Level     string
+		Msg       string
+		Method    string
+		Path      string
+		Status    int
+		RequestID string `json:"request_id"`
+		Attempt   int
+		Headers   map[string]string
+		Error     string
+	}
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("failed to decode log record: %v", err)
+	}
+	if record.Level != "DEBUG" || record.Method != http.MethodPost || record.Path != "/api/auth/login" ||
+		record.Status != http.StatusServiceUnavailable || record.RequestID != "req-1" || record.Attempt != 2 || record.Error != "unavailable" {
+		t.Errorf("record = %+v", record)
+	}
+	if record.Headers["X-Api-Key"] != Redacted || record.Headers["Accept"] != "application/json" {
+		t.Errorf("headers = %v", record.Headers)
+	}
+}
+
+// TestLogRequest_Disabled tests that nothing is logged without a debug logger.
+func TestLogRequest_Disabled(t *testing.T) {
+	t.Parallel()
+
+	LogRequest(context.Background(), nil, Request{Method: http.MethodGet})
+
+	var buf bytes.Buffer
+	LogRequest(context.Background(), slog.New(slog.NewTextHandler(&buf, nil)), Request{Method: http.MethodGet})
+	if buf.Len() != 0 {
+		t.Errorf("logged at info level: %s", buf.String())
+	}
+}
@@ -0,0 +1,218 @@
+// Package otelunifi instruments the UniFi SDK with OpenTelemetry tracing and metrics.
+//
+// It is a separate module, so the core SDK does not depend on OpenTelemetry. Add the middleware to a client:
+//
+//	client, err := unifi.New(
+//		unifi.ConfigAPIKey(apiKey),
+//		unifi.ConfigMiddleware(otelunifi.Middleware()),
+//	)
+//
+//	network, err := unifi.NewNetwork(network.Config{
+//		BaseURL:     "https://192.168.1.1",
+//		Middlewares: []middleware.Middleware{otelunifi.Middleware()},
+//	})
+//
+// Every HTTP request, including each retry, gets a client span and is recorded in the request duration
+// histogram; failed requests are also counted in the error counter.
+package otelunifi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ilmax/unifi-client-go/pkg/middleware"
+)
+
+// ScopeName is the instrumentation scope of the tracer and meter.
+const ScopeName = "github.com/ilmax/unifi-client-go/otelunifi"
+
+// Metric names.
+const (
+	RequestDurationMetric = "unifi.client.request.duration"
+	RequestErrorsMetric   = "unifi.client.request.errors"
+)
+
+// Attribute keys.
+const (
+	RequestIDKey = attribute.Key("unifi.request_id")
+	TraceIDKey   = attribute.Key("unifi.trace_id")
+)
+
+type options struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// Option configures the instrumentation.
+type Option func(*options)
+
+// WithTracerProvider sets the tracer provider (default: otel.GetTracerProvider()).
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(o *options) {
+		o.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the meter provider (default: otel.GetMeterProvider()).
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(o *options) {
+		o.meterProvider = provider
+	}
+}
+
+// WithPropagator sets the propagator injecting the trace context into requests (default: otel.GetTextMapPropagator()).
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(o *options) {
+		o.propagator = propagator
+	}
+}
+
+type instrumentation struct {
+	tracer     trace.Tracer
+	duration   metric.Float64Histogram
+	failures   metric.Int64Counter
+	propagator propagation.TextMapPropagator
+}
+
+// Middleware returns a middleware that traces and measures every request sent by a UniFi client.
+func Middleware(opts ...Option) middleware.Middleware {
+	o := options{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+		propagator:     otel.GetTextMapPropagator(),
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	meter := o.meterProvider.Meter(ScopeName)
+	duration, err := meter.Float64Histogram(RequestDurationMetric,
+		metric.WithDescription("Duration of UniFi API requests."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	failures, err := meter.Int64Counter(RequestErrorsMetric,
+		metric.WithDescription("Number of failed UniFi API requests."),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	inst := &instrumentation{
+		tracer:     o.tracerProvider.Tracer(ScopeName),
+		duration:   duration,
+		failures:   failures,
+		propagator: o.propagator,
+	}
+	return inst.wrap
+}
+
+func (inst *instrumentation) wrap(next middleware.Doer) middleware.Doer {
+	return middleware.DoerFunc(func(req *http.Request) (*http.Response, error) {
+		route := middleware.RouteTemplate(req.URL.Path)
+		attrs := []attribute.KeyValue{
+			attribute.String("http.request.method", req.Method),
+			attribute.String("url.template", route),
+			attribute.String("server.address", req.URL.Hostname()),
+		}
+
+		ctx, span := inst.tracer.Start(req.Context(), req.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attrs...),
+		)
+		defer span.End()
+
+		req = req.Clone(ctx)
+		inst.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+		start := time.Now()
+		resp, err := next.Do(req)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			attrs = append(attrs, attribute.String("error.type", errorType(err)))
+			inst.record(req, start, attrs, true)
+			return resp, err
+		}
+
+		attrs = append(attrs, attribute.Int("http.response.status_code", resp.StatusCode))
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		if id := resp.Header.Get("X-Request-Id"); id != "" {
+			span.SetAttributes(RequestIDKey.String(id))
+		}
+		if id := traceID(resp); id != "" {
+			span.SetAttributes(TraceIDKey.String(id))
+		}
+
+		failed := resp.StatusCode >= 400
+		if failed {
+			span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+			attrs = append(attrs, attribute.String("error.type", strconv.Itoa(resp.StatusCode)))
+		}
+		inst.record(req, start, attrs, failed)
+		return resp, nil
+	})
+}
+
+func (inst *instrumentation) record(req *http.Request, start time.Time, attrs []attribute.KeyValue, failed bool) {
+	set := metric.WithAttributeSet(attribute.NewSet(attrs...))
+	inst.duration.Record(req.Context(), time.Since(start).Seconds(), set)
+	if failed {
+		inst.failures.Add(req.Context(), 1, set)
+	}
+}
+
+// traceID returns the traceId field of a Site Manager JSON response, leaving the body readable.
+func traceID(resp *http.Response) string {
+	if mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type")); resp.Body == nil || mediaType != "application/json" {
+		return ""
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		// Replay what was read, then the read error, to the client.
+		resp.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), errReader{err}))
+		return ""
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var envelope struct {
+		TraceID string `json:"traceId"`
+	}
+	if json.Unmarshal(body, &envelope) != nil {
+		return ""
+	}
+	return envelope.TraceID
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+// errorType returns a low-cardinality description of a transport error.
+func errorType(err error) string {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return "timeout"
+	}
+	return "_OTHER"
+}
@@ -0,0 +1,120 @@
+package otelunifi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/ilmax/unifi-client-go/unifi"
+)
+
+// TestMiddleware tests the spans and metrics recorded for Site Manager requests.
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("Traceparent")
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/v1/hosts/missing:1" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":"NOT_FOUND","httpStatusCode":404,"traceId":"trace-404"}`))
+			return
+		}
+		w.Header().Set("X-Request-Id", "req-1")
+		_, _ = w.Write([]byte(`{"data":{"id":"host:1"},"httpStatusCode":200,"traceId":"trace-200"}`))
+	}))
+	defer server.Close()
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	mw := Middleware(
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+		WithPropagator(propagation.TraceContext{}),
+	)
+
+	client, err := unifi.New(
+		unifi.ConfigAPIKey("test-key"),
+		unifi.ConfigBaseURL(server.URL),
+		unifi.ConfigMiddleware(mw),
+	)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ctx := context.Background()
+	host, err := client.SiteManager.GetHostByIDWithContext(ctx, "host:1")
+	if err != nil {
+		t.Fatalf("GetHostByIDWithContext() error = %v", err)
+	}
+	if host.ID != "host:1" {
+		t.Errorf("host.ID = %q, want the response body to stay readable", host.ID)
+	}
+	if traceparent == "" {
+		t.Error("request carried no traceparent header")
+	}
+	if _, err := client.SiteManager.GetHostByIDWithContext(ctx, "missing:1"); err == nil {
+		t.Fatal("expected error for missing host")
+	}
+
+	ended := spans.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("spans = %d, want 2", len(ended))
+	}
+	ok, failed := ended[0], ended[1]
+	if ok.Name() != "GET /v1/hosts/{id}" {
+		t.Errorf("span name = %q, want %q", ok.Name(), "GET /v1/hosts/{id}")
+	}
+	want := map[attribute.Key]attribute.Value{
+		"http.request.method":       attribute.StringValue(http.MethodGet),
+		"url.template":              attribute.StringValue("/v1/hosts/{id}"),
+		"http.response.status_code": attribute.IntValue(http.StatusOK),
+		RequestIDKey:                attribute.StringValue("req-1"),
+		TraceIDKey:                  attribute.StringValue("trace-200"),
+	}
+	got := map[attribute.Key]attribute.Value{}
+	for _, kv := range ok.Attributes() {
+		got[kv.Key] = kv.Value
+	}
+	for key, value := range want {
+		if got[key] != value {
+			t.Errorf("span attribute %s = %v, want %v", key, got[key].Emit(), value.Emit())
+		}
+	}
+	if failed.Status().Code != codes.Error {
+		t.Errorf("failed span status = %v, want Error", failed.Status())
+	}
+
+	var metrics metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &metrics); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	counts := map[string]uint64{}
+	for _, scope := range metrics.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Histogram[float64]:
+				for _, point := range data.DataPoints {
+					counts[m.Name] += point.Count
+				}
+			case metricdata.Sum[int64]:
+				for _, point := range data.DataPoints {
+					counts[m.Name] += uint64(point.Value)
+				}
+			}
+		}
+	}
+	if counts[RequestDurationMetric] != 2 || counts[RequestErrorsMetric] != 1 {
+		t.Errorf("metrics = %v, want 2 durations and 1 error", counts)
+	}
+}
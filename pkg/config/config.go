@@ -2,14 +2,20 @@
 package config
 
 import (
+	"log/slog"
 	"net/http"
 	"strings"
 	"time"
+
+	"github.com/ilmax/unifi-client-go/pkg/middleware"
 )
 
 const (
 	DefaultTimeout   = 30 * time.Second
 	DefaultUserAgent = "unifi-go-sdk/1.0"
+
+	DefaultRetryBaseDelay = 500 * time.Millisecond
+	DefaultRetryMaxDelay  = 30 * time.Second
 )
 
 // Config contains the configuration for the UniFi SDK.
@@ -19,6 +25,34 @@ type Config struct {
 	HTTPClient *http.Client
 	UserAgent  string
 	Timeout    time.Duration
+	Retry      RetryPolicy
+	RateLimit  RateLimit
+	// Middlewares wrap every request sent to the API, the first one being the outermost.
+	Middlewares []middleware.Middleware
+	// Logger receives a debug record for every request attempt. Secrets are always redacted.
+	Logger *slog.Logger
+}
+
+// RateLimit configures a client-side token bucket shared by every request of a client.
+// The zero value disables it.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained request rate.
+	RequestsPerSecond float64
+	// Burst is the number of requests that may be sent at once (default: 1).
+	Burst int
+}
+
+// RetryPolicy configures how requests failing with 429, 502, 503, 504 or a transient network error are retried.
+// The zero value disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry. It doubles on each retry, up to MaxDelay,
+	// with random jitter. A longer Retry-After header takes precedence.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// RetryPOST allows retrying POST requests, which are not idempotent.
+	RetryPOST bool
 }
 
 // ConfigOption is a function that configures the Config.
@@ -81,3 +115,53 @@ func ConfigUserAgent(userAgent string) ConfigOption {
 		c.UserAgent = userAgent
 	}
 }
+
+// ConfigRetry enables retries, making up to maxAttempts attempts per request.
+func ConfigRetry(maxAttempts int) ConfigOption {
+	return func(c *Config) {
+		c.Retry.MaxAttempts = maxAttempts
+		if c.Retry.BaseDelay == 0 {
+			c.Retry.BaseDelay = DefaultRetryBaseDelay
+		}
+		if c.Retry.MaxDelay == 0 {
+			c.Retry.MaxDelay = DefaultRetryMaxDelay
+		}
+	}
+}
+
+// ConfigRetryBackoff sets the delay before the first retry and the maximum delay between retries.
+func ConfigRetryBackoff(baseDelay, maxDelay time.Duration) ConfigOption {
+	return func(c *Config) {
+		c.Retry.BaseDelay = baseDelay
+		c.Retry.MaxDelay = maxDelay
+	}
+}
+
+// ConfigRetryPOST allows retrying POST requests. Only enable it if the POST endpoints used are safe to repeat.
+func ConfigRetryPOST() ConfigOption {
+	return func(c *Config) {
+		c.Retry.RetryPOST = true
+	}
+}
+
+// ConfigRateLimit limits requests to requestsPerSecond on average, allowing bursts of up to burst requests.
+// The limiter also waits out quotas reported through X-RateLimit-* and Retry-After headers.
+func ConfigRateLimit(requestsPerSecond float64, burst int) ConfigOption {
+	return func(c *Config) {
+		c.RateLimit = RateLimit{RequestsPerSecond: requestsPerSecond, Burst: burst}
+	}
+}
+
+// ConfigMiddleware adds middlewares wrapping every request. Middlewares run in the order they are added.
+func ConfigMiddleware(middlewares ...middleware.Middleware) ConfigOption {
+	return func(c *Config) {
+		c.Middlewares = append(c.Middlewares, middlewares...)
+	}
+}
+
+// ConfigLogger logs every request attempt at debug level, with API keys, cookies, CSRF tokens and passwords redacted.
+func ConfigLogger(logger *slog.Logger) ConfigOption {
+	return func(c *Config) {
+		c.Logger = logger
+	}
+}
@@ -2,9 +2,12 @@
 package config
 
 import (
+	"log/slog"
 	"net/http"
 	"testing"
 	"time"
+
+	"github.com/ilmax/unifi-client-go/pkg/middleware"
 )
 
 func TestNew(t *testing.T) {
@@ -306,3 +309,75 @@ func TestInit(t *testing.T) {
 		}
 	})
 }
+
+func TestConfigRetry(t *testing.T) {
+	t.Run("enables retries with default backoff", func(t *testing.T) {
+		cfg := New()
+		ConfigRetry(3)(&cfg)
+
+		if cfg.Retry.MaxAttempts != 3 {
+			t.Errorf("MaxAttempts = %d, want 3", cfg.Retry.MaxAttempts)
+		}
+		if cfg.Retry.BaseDelay != DefaultRetryBaseDelay || cfg.Retry.MaxDelay != DefaultRetryMaxDelay {
+			t.Errorf("backoff = %v-%v, want %v-%v", cfg.Retry.BaseDelay, cfg.Retry.MaxDelay, DefaultRetryBaseDelay, DefaultRetryMaxDelay)
+		}
+		if cfg.Retry.RetryPOST {
+			t.Error("RetryPOST = true, want false")
+		}
+	})
+
+	t.Run("keeps a custom backoff", func(t *testing.T) {
+		cfg := New()
+		if err := cfg.Init([]ConfigOption{
+			ConfigRetryBackoff(time.Second, time.Minute),
+			ConfigRetry(5),
+			ConfigRetryPOST(),
+		}); err != nil {
+			t.Fatalf("Init() error = %v, want nil", err)
+		}
+
+		want := RetryPolicy{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: time.Minute, RetryPOST: true}
+		if cfg.Retry != want {
+			t.Errorf("Retry = %+v, want %+v", cfg.Retry, want)
+		}
+	})
+}
+
+func TestConfigRateLimit(t *testing.T) {
+	cfg := New()
+	if cfg.RateLimit != (RateLimit{}) {
+		t.Errorf("RateLimit = %+v, want disabled by default", cfg.RateLimit)
+	}
+
+	ConfigRateLimit(5, 10)(&cfg)
+
+	want := RateLimit{RequestsPerSecond: 5, Burst: 10}
+	if cfg.RateLimit != want {
+		t.Errorf("RateLimit = %+v, want %+v", cfg.RateLimit, want)
+	}
+}
+
+func TestConfigMiddleware(t *testing.T) {
+	logging := middleware.Logging(func(string, ...any) {})
+	headers := middleware.Headers(http.Header{"X-Trace": {"1"}})
+
+	cfg := New()
+	if err := cfg.Init([]ConfigOption{ConfigMiddleware(logging), ConfigMiddleware(headers)}); err != nil {
+		t.Fatalf("Init() error = %v, want nil", err)
+	}
+
+	if len(cfg.Middlewares) != 2 {
+		t.Errorf("Middlewares = %d, want 2", len(cfg.Middlewares))
+	}
+}
+
+func TestConfigLogger(t *testing.T) {
+	logger := slog.Default()
+
+	cfg := New()
+	ConfigLogger(logger)(&cfg)
+
+	if cfg.Logger != logger {
+		t.Errorf("Logger = %v, want %v", cfg.Logger, logger)
+	}
+}
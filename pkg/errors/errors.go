@@ -9,10 +9,26 @@ import (
 
 // Common errors
 var (
-	ErrEmptyAPIKey     = errors.New("API key cannot be empty")
-	ErrInvalidInterval = errors.New("invalid ISP metrics interval: must be '5m' or '1h'")
-	ErrEmptyConfigID   = errors.New("config ID cannot be empty")
-	ErrEmptyHostID     = errors.New("host ID cannot be empty")
+	ErrEmptyAPIKey                = errors.New("API key cannot be empty")
+	ErrInvalidInterval            = errors.New("invalid ISP metrics interval: must be '5m' or '1h'")
+	ErrEmptyConfigID              = errors.New("config ID cannot be empty")
+	ErrEmptyHostID                = errors.New("host ID cannot be empty")
+	ErrEmptySiteID                = errors.New("site ID cannot be empty")
+	ErrEmptyClientID              = errors.New("client ID cannot be empty")
+	ErrEmptyDeviceID              = errors.New("device ID cannot be empty")
+	ErrEmptyCredentials           = errors.New("username and password cannot be empty")
+	ErrEmptyNetworkID             = errors.New("network ID cannot be empty")
+	ErrNetworkInUse               = errors.New("network is still referenced by other resources")
+	ErrDeviceNotFound             = errors.New("device not found")
+	ErrEmptyVoucherID             = errors.New("voucher ID cannot be empty")
+	ErrEmptyWLANID                = errors.New("WLAN ID cannot be empty")
+	ErrEmptyZoneID                = errors.New("firewall zone ID cannot be empty")
+	ErrEmptyPolicyID              = errors.New("firewall policy ID cannot be empty")
+	ErrEmptyACLRuleID             = errors.New("ACL rule ID cannot be empty")
+	ErrEmptyDNSPolicyID           = errors.New("DNS policy ID cannot be empty")
+	ErrEmptyTrafficMatchingListID = errors.New("traffic matching list ID cannot be empty")
+	ErrResourceNotFound           = errors.New("resource not found")
+	ErrClientNotFound             = errors.New("client not found")
 )
 
 // APIError represents an error returned by the UniFi API.
@@ -20,6 +36,8 @@ type APIError struct {
 	StatusCode int
 	Message    string
 	RequestID  string
+	// Attempts is the number of attempts made before giving up, when the request was retried.
+	Attempts int
 }
 
 // NewAPIError creates a new APIError.
@@ -33,6 +51,12 @@ func NewAPIError(statusCode int, message, requestID string) *APIError {
 
 // Error implements the error interface.
 func (e *APIError) Error() string {
+	if e.Attempts > 1 {
+		if e.RequestID != "" {
+			return fmt.Sprintf("API error (status %d, request_id: %s, attempts: %d): %s", e.StatusCode, e.RequestID, e.Attempts, e.Message)
+		}
+		return fmt.Sprintf("API error (status %d, attempts: %d): %s", e.StatusCode, e.Attempts, e.Message)
+	}
 	if e.RequestID != "" {
 		return fmt.Sprintf("API error (status %d, request_id: %s): %s", e.StatusCode, e.RequestID, e.Message)
 	}
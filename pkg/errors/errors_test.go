@@ -32,6 +32,16 @@ func TestAPIError_Error(t *testing.T) {
 			},
 			expected: "API error (status 500): Internal Server Error",
 		},
+		{
+			name: "after retries",
+			err: &APIError{
+				StatusCode: 503,
+				Message:    "Service Unavailable",
+				RequestID:  "req-456",
+				Attempts:   3,
+			},
+			expected: "API error (status 503, request_id: req-456, attempts: 3): Service Unavailable",
+		},
 	}
 
 	for _, tt := range tests {
@@ -187,6 +197,54 @@ func TestCommonErrors(t *testing.T) {
 	if ErrEmptyHostID == nil {
 		t.Error("ErrEmptyHostID should not be nil")
 	}
+	if ErrEmptySiteID == nil {
+		t.Error("ErrEmptySiteID should not be nil")
+	}
+	if ErrEmptyClientID == nil {
+		t.Error("ErrEmptyClientID should not be nil")
+	}
+	if ErrEmptyDeviceID == nil {
+		t.Error("ErrEmptyDeviceID should not be nil")
+	}
+	if ErrEmptyCredentials == nil {
+		t.Error("ErrEmptyCredentials should not be nil")
+	}
+	if ErrEmptyNetworkID == nil {
+		t.Error("ErrEmptyNetworkID should not be nil")
+	}
+	if ErrNetworkInUse == nil {
+		t.Error("ErrNetworkInUse should not be nil")
+	}
+	if ErrDeviceNotFound == nil {
+		t.Error("ErrDeviceNotFound should not be nil")
+	}
+	if ErrEmptyVoucherID == nil {
+		t.Error("ErrEmptyVoucherID should not be nil")
+	}
+	if ErrEmptyWLANID == nil {
+		t.Error("ErrEmptyWLANID should not be nil")
+	}
+	if ErrEmptyZoneID == nil {
+		t.Error("ErrEmptyZoneID should not be nil")
+	}
+	if ErrEmptyPolicyID == nil {
+		t.Error("ErrEmptyPolicyID should not be nil")
+	}
+	if ErrEmptyACLRuleID == nil {
+		t.Error("ErrEmptyACLRuleID should not be nil")
+	}
+	if ErrEmptyDNSPolicyID == nil {
+		t.Error("ErrEmptyDNSPolicyID should not be nil")
+	}
+	if ErrEmptyTrafficMatchingListID == nil {
+		t.Error("ErrEmptyTrafficMatchingListID should not be nil")
+	}
+	if ErrResourceNotFound == nil {
+		t.Error("ErrResourceNotFound should not be nil")
+	}
+	if ErrClientNotFound == nil {
+		t.Error("ErrClientNotFound should not be nil")
+	}
 }
 
 func TestValidationError_Error(t *testing.T) {
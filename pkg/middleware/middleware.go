@@ -0,0 +1,117 @@
+// Package middleware provides hooks around the HTTP requests sent by the UniFi SDK.
+//
+// A Middleware wraps the Doer that sends a request, so it can change the request, inspect or replace the
+// response, or skip the network entirely (for example to stub responses in tests).
+package middleware
+
+import (
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+)
+
+// Doer sends an HTTP request and returns its response. *http.Client implements it.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc adapts a function to a Doer.
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req).
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps a Doer with additional behavior.
+type Middleware func(next Doer) Doer
+
+// Chain wraps doer with middlewares. The first middleware is the outermost one,
+// so it sees the request first and the response last.
+func Chain(doer Doer, middlewares ...Middleware) Doer {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		doer = middlewares[i](doer)
+	}
+	return doer
+}
+
+// Headers returns a middleware that sets the given headers on every request, replacing existing values.
+func Headers(headers http.Header) Middleware {
+	headers = headers.Clone()
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			for key, values := range headers {
+				req.Header[key] = values
+			}
+			return next.Do(req)
+		})
+	}
+}
+
+// Logging returns a middleware that logs the method, path, status and duration of every request with logf,
+// for example log.Printf. Headers and bodies are never logged.
+func Logging(logf func(format string, args ...any)) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next.Do(req)
+			duration := time.Since(start)
+
+			if err != nil {
+				logf("%s %s failed after %v: %v", req.Method, req.URL.Path, duration, err)
+				return resp, err
+			}
+			logf("%s %s %d %v", req.Method, req.URL.Path, resp.StatusCode, duration)
+			return resp, nil
+		})
+	}
+}
+
+// RouteTemplate returns a low-cardinality form of a request path, suitable for span names and metrics.
+// Segments that look like identifiers (numbers, UUIDs, hex object IDs, MAC and IP addresses, host IDs)
+// become {id}, and the site name of classic /api/s/{site} routes becomes {site}:
+//
+//	/v1/hosts/70A7419783ED:1234  ->  /v1/hosts/{id}
+//	/api/s/default/stat/sta      ->  /api/s/{site}/stat/sta
+func RouteTemplate(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		switch {
+		case segment == "":
+		case i > 1 && segments[i-1] == "s" && (segments[i-2] == "api" || segments[i-2] == "wss"):
+			segments[i] = "{site}"
+		case isIdentifier(segment):
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// isIdentifier reports whether a path segment is a resource identifier rather than part of the route.
+func isIdentifier(segment string) bool {
+	if strings.ContainsRune(segment, ':') {
+		return true
+	}
+	if _, err := netip.ParseAddr(segment); err == nil {
+		return true
+	}
+
+	var digits, hex int
+	for _, r := range segment {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+			hex++
+		case r >= 'a' && r <= 'f', r >= 'A' && r <= 'F', r == '-':
+			hex++
+		}
+	}
+	if digits == 0 {
+		return false
+	}
+	return digits == len(segment) || (len(segment) >= 12 && hex == len(segment)) || len(segment) >= 16
+}
@@ -0,0 +1,123 @@
+package middleware
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// stub returns a Doer answering every request with status and recording the requests it receives.
+func stub(status int, requests *[]*http.Request) Doer {
+	return DoerFunc(func(req *http.Request) (*http.Response, error) {
+		*requests = append(*requests, req)
+		return &http.Response{StatusCode: status, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("{}")), Request: req}, nil
+	})
+}
+
+// TestChain tests that middlewares run in order.
+func TestChain(t *testing.T) {
+	t.Parallel()
+
+	var order []string
+	trace := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, name+" request")
+				resp, err := next.Do(req)
+				order = append(order, name+" response")
+				return resp, err
+			})
+		}
+	}
+
+	var requests []*http.Request
+	doer := Chain(stub(http.StatusOK, &requests), trace("outer"), trace("inner"))
+
+	req := httptest.NewRequest(http.MethodGet, "https://api.ui.com/v1/hosts", nil)
+	if _, err := doer.Do(req); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+
+	want := "outer request,inner request,inner response,outer response"
+	if got := strings.Join(order, ","); got != want {
+		t.Errorf("order = %s, want %s", got, want)
+	}
+	if len(requests) != 1 {
+		t.Errorf("requests = %d, want 1", len(requests))
+	}
+}
+
+// TestHeaders tests the header injection middleware.
+func TestHeaders(t *testing.T) {
+	t.Parallel()
+
+	var requests []*http.Request
+	doer := Chain(stub(http.StatusOK, &requests), Headers(http.Header{"Traceparent": {"00-abc-def-01"}, "User-Agent": {"custom"}}))
+
+	req := httptest.NewRequest(http.MethodGet, "https://api.ui.com/v1/hosts", nil)
+	req.Header.Set("User-Agent", "unifi-go-sdk/1.0")
+	if _, err := doer.Do(req); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+
+	sent := requests[0].Header
+	if sent.Get("Traceparent") != "00-abc-def-01" || sent.Get("User-Agent") != "custom" {
+		t.Errorf("headers = %v", sent)
+	}
+	if req.Header.Get("User-Agent") != "unifi-go-sdk/1.0" {
+		t.Error("Headers() modified the caller's request")
+	}
+}
+
+// TestLogging tests the logging middleware.
+func TestLogging(t *testing.T) {
+	t.Parallel()
+
+	var lines []string
+	logf := func(format string, args ...any) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+
+	var requests []*http.Request
+	doer := Chain(stub(http.StatusNotFound, &requests), Logging(logf))
+
+	req := httptest.NewRequest(http.MethodDelete, "https://api.ui.com/v1/hosts/1?force=true", nil)
+	req.Header.Set("X-API-Key", "secret")
+	if _, err := doer.Do(req); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+
+	if len(lines) != 1 || !strings.HasPrefix(lines[0], "DELETE /v1/hosts/1 404 ") {
+		t.Errorf("lines = %q", lines)
+	}
+	if strings.Contains(lines[0], "secret") {
+		t.Errorf("log line leaks the API key: %q", lines[0])
+	}
+}
+
+// TestRouteTemplate tests that identifiers are removed from request paths.
+func TestRouteTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"/v1/hosts": "/v1/hosts",
+		"/v1/hosts/900A6F00301100000000074A6BA9:12345":                                                                      "/v1/hosts/{id}",
+		"/v1/sdwan/configs/0b1d2f3c-4e5f-6071-8293-a4b5c6d7e8f9/status":                                                     "/v1/sdwan/configs/{id}/status",
+		"/v1/isp-metrics/5m?duration=24h":                                                                                   "/v1/isp-metrics/5m",
+		"/proxy/network/integration/v1/sites/88f7af54-98f8-306a-a1c7-c9349722b1f6/devices/5f2a1b3c4d5e6f7a8b9c0d1e/actions": "/proxy/network/integration/v1/sites/{id}/devices/{id}/actions",
+		"/api/s/default/stat/user/aa:bb:cc:dd:ee:ff":                                                                        "/api/s/{site}/stat/user/{id}",
+		"/proxy/network/api/s/branch/rest/user/60d5ec49f1a4c2b3d4e5f6a7":                                                    "/proxy/network/api/s/{site}/rest/user/{id}",
+		"/wss/s/default/events":                                                                                             "/wss/s/{site}/events",
+		"/v1/sites/abc/clients/192.168.1.20":                                                                                "/v1/sites/abc/clients/{id}",
+		"/v1/vouchers/42":                                                                                                   "/v1/vouchers/{id}",
+		"/api/self/sites":                                                                                                   "/api/self/sites",
+	}
+	for path, want := range tests {
+		if got := RouteTemplate(path); got != want {
+			t.Errorf("RouteTemplate(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+type ListACLRulesRequest struct {
+	SiteID string `json:"siteId"`
+
+	Offset int    `json:"offset"`
+	Limit  int    `json:"limit"`
+	Filter string `json:"filter"`
+}
+
+// ToQuery converts the request to URL query string.
+func (r *ListACLRulesRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
+type ListACLRulesResponse struct {
+	Offset     int       `json:"offset"`
+	Limit      int       `json:"limit"`
+	Count      int       `json:"count"`
+	TotalCount int       `json:"totalCount"`
+	Data       []ACLRule `json:"data"`
+}
+
+// GET /v1/sites/{siteId}/acl-rules
+
+// ListACLRules retrieves the ACL rules of a site.
+func (n *Network) ListACLRules(ctx context.Context, req *ListACLRulesRequest) (*ListACLRulesResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/acl-rules", req.SiteID) + req.ToQuery()
+
+	var resp ListACLRulesResponse
+	if err := n.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type ACLRuleDetailsRequest struct {
+	ACLRuleID string `json:"aclRuleId"`
+	SiteID    string `json:"siteId"`
+}
+
+type ACLRuleDetailsResponse struct {
+	ACLRule
+}
+
+// GET /v1/sites/{siteId}/acl-rules/{aclRuleId}
+
+// GetACLRule retrieves a single ACL rule.
+func (n *Network) GetACLRule(ctx context.Context, req *ACLRuleDetailsRequest) (*ACLRuleDetailsResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.ACLRuleID) == "" {
+		return nil, errors.ErrEmptyACLRuleID
+	}
+
+	var resp ACLRuleDetailsResponse
+	if err := n.get(ctx, fmt.Sprintf("/v1/sites/%s/acl-rules/%s", req.SiteID, req.ACLRuleID), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type CreateACLRuleRequest struct {
+	SiteID string `json:"-"`
+
+	ACLRuleType           ACLRuleType      `json:"type"`
+	Name                  string           `json:"name"`
+	Description           string           `json:"description,omitempty"`
+	Enabled               bool             `json:"enabled"`
+	Action                ACLRuleAction    `json:"action"`
+	EnforcingDeviceFilter *ACLDeviceFilter `json:"enforcingDeviceFilter,omitempty"`
+	SourceFilter          *ACLRuleFilter   `json:"sourceFilter,omitempty"`
+	DestinationFilter     *ACLRuleFilter   `json:"destinationFilter,omitempty"`
+	ProtocolFilter        []string         `json:"protocolFilter,omitempty"`
+}
+
+type CreateACLRuleResponse struct {
+	ACLRule
+}
+
+// POST /v1/sites/{siteId}/acl-rules
+
+// CreateACLRule creates a new ACL rule.
+func (n *Network) CreateACLRule(ctx context.Context, req *CreateACLRuleRequest) (*CreateACLRuleResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if err := validateACLRule(req.ACLRuleType, req.SourceFilter, req.DestinationFilter, req.ProtocolFilter); err != nil {
+		return nil, err
+	}
+
+	var resp CreateACLRuleResponse
+	if err := n.post(ctx, fmt.Sprintf("/v1/sites/%s/acl-rules", req.SiteID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type UpdateACLRuleRequest struct {
+	ACLRuleID string `json:"-"`
+	SiteID    string `json:"-"`
+
+	ACLRuleType           ACLRuleType      `json:"type"`
+	Name                  string           `json:"name"`
+	Description           string           `json:"description,omitempty"`
+	Enabled               bool             `json:"enabled"`
+	Action                ACLRuleAction    `json:"action"`
+	EnforcingDeviceFilter *ACLDeviceFilter `json:"enforcingDeviceFilter,omitempty"`
+	SourceFilter          *ACLRuleFilter   `json:"sourceFilter,omitempty"`
+	DestinationFilter     *ACLRuleFilter   `json:"destinationFilter,omitempty"`
+	ProtocolFilter        []string         `json:"protocolFilter,omitempty"`
+}
+
+type UpdateACLRuleResponse struct {
+	ACLRule
+}
+
+// PUT /v1/sites/{siteId}/acl-rules/{aclRuleId}
+
+// UpdateACLRule replaces the configuration of an ACL rule.
+func (n *Network) UpdateACLRule(ctx context.Context, req *UpdateACLRuleRequest) (*UpdateACLRuleResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.ACLRuleID) == "" {
+		return nil, errors.ErrEmptyACLRuleID
+	}
+	if err := validateACLRule(req.ACLRuleType, req.SourceFilter, req.DestinationFilter, req.ProtocolFilter); err != nil {
+		return nil, err
+	}
+
+	var resp UpdateACLRuleResponse
+	if err := n.put(ctx, fmt.Sprintf("/v1/sites/%s/acl-rules/%s", req.SiteID, req.ACLRuleID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type DeleteACLRuleRequest struct {
+	ACLRuleID string `json:"aclRuleId"`
+	SiteID    string `json:"siteId"`
+}
+
+// DELETE /v1/sites/{siteId}/acl-rules/{aclRuleId}
+
+// DeleteACLRule deletes an ACL rule.
+func (n *Network) DeleteACLRule(ctx context.Context, req *DeleteACLRuleRequest) error {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.ACLRuleID) == "" {
+		return errors.ErrEmptyACLRuleID
+	}
+
+	return n.delete(ctx, fmt.Sprintf("/v1/sites/%s/acl-rules/%s", req.SiteID, req.ACLRuleID), nil)
+}
+
+type ACLRuleOrderingResponse struct {
+	ACLRuleOrdering
+}
+
+// GET /v1/sites/{siteId}/acl-rules/ordering
+
+// GetACLRuleOrdering retrieves the evaluation order of the ACL rules of a site.
+func (n *Network) GetACLRuleOrdering(ctx context.Context, siteID string) (*ACLRuleOrderingResponse, error) {
+	if strings.TrimSpace(siteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	var resp ACLRuleOrderingResponse
+	if err := n.get(ctx, fmt.Sprintf("/v1/sites/%s/acl-rules/ordering", siteID), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// PUT /v1/sites/{siteId}/acl-rules/ordering
+
+// ReorderACLRules sets the evaluation order of the ACL rules of a site.
+// orderedIDs must contain every user-defined ACL rule ID exactly once.
+func (n *Network) ReorderACLRules(ctx context.Context, siteID string, orderedIDs []string) (*ACLRuleOrderingResponse, error) {
+	if strings.TrimSpace(siteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	seen := make(map[string]bool, len(orderedIDs))
+	for _, id := range orderedIDs {
+		if strings.TrimSpace(id) == "" {
+			return nil, errors.ErrEmptyACLRuleID
+		}
+		if seen[id] {
+			return nil, errors.NewValidationError("orderedAclRuleIds", fmt.Sprintf("duplicate ACL rule ID %s", id))
+		}
+		seen[id] = true
+	}
+
+	var resp ACLRuleOrderingResponse
+	body := ACLRuleOrdering{OrderedACLRuleIDs: orderedIDs}
+	if err := n.put(ctx, fmt.Sprintf("/v1/sites/%s/acl-rules/ordering", siteID), body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// validateACLRule checks that the rule filters are compatible with the rule type.
+func validateACLRule(ruleType ACLRuleType, source, destination *ACLRuleFilter, protocols []string) error {
+	if ruleType != ACLRuleTypeIPv4 && ruleType != ACLRuleTypeMAC {
+		return errors.NewValidationError("type", fmt.Sprintf("must be %s or %s", ACLRuleTypeIPv4, ACLRuleTypeMAC))
+	}
+	if ruleType == ACLRuleTypeMAC && len(protocols) > 0 {
+		return errors.NewValidationError("protocolFilter", "is only supported by IPV4 rules")
+	}
+	if err := source.validate("sourceFilter", ruleType); err != nil {
+		return err
+	}
+	return destination.validate("destinationFilter", ruleType)
+}
@@ -0,0 +1,160 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	pkgerrors "github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// TestNetwork_CreateACLRule tests that filters incompatible with the rule type are rejected before the request is sent.
+func TestNetwork_CreateACLRule(t *testing.T) {
+	t.Parallel()
+
+	ipFilter := &ACLRuleFilter{ACLRuleFilterType: ACLRuleFilterTypeIPAddresses, IPAddressesOrSubnets: []string{"10.0.0.0/24"}, PortFilter: []string{"22"}}
+	networkFilter := &ACLRuleFilter{ACLRuleFilterType: ACLRuleFilterTypeNetworks, NetworkIDs: []string{"net-1"}}
+	macFilter := &ACLRuleFilter{ACLRuleFilterType: ACLRuleFilterTypeMACAddresses, MACAddresses: []string{"aa:bb:cc:dd:ee:ff"}}
+
+	tests := []struct {
+		name        string
+		ruleType    ACLRuleType
+		source      *ACLRuleFilter
+		destination *ACLRuleFilter
+		protocols   []string
+		wantField   string
+	}{
+		{name: "ipv4 rule", ruleType: ACLRuleTypeIPv4, source: ipFilter, destination: networkFilter, protocols: []string{"TCP"}},
+		{name: "mac rule", ruleType: ACLRuleTypeMAC, source: macFilter},
+		{name: "rule without filters", ruleType: ACLRuleTypeIPv4},
+		{name: "unknown rule type", ruleType: "IPV6", source: ipFilter, wantField: "type"},
+		{name: "mac filter in ipv4 rule", ruleType: ACLRuleTypeIPv4, source: macFilter, wantField: "sourceFilter"},
+		{name: "network filter in mac rule", ruleType: ACLRuleTypeMAC, destination: networkFilter, wantField: "destinationFilter"},
+		{
+			name:      "port filter in mac rule",
+			ruleType:  ACLRuleTypeMAC,
+			source:    &ACLRuleFilter{ACLRuleFilterType: ACLRuleFilterTypeMACAddresses, MACAddresses: []string{"aa:bb:cc:dd:ee:ff"}, PortFilter: []string{"80"}},
+			wantField: "sourceFilter.portFilter",
+		},
+		{name: "protocol filter in mac rule", ruleType: ACLRuleTypeMAC, source: macFilter, protocols: []string{"UDP"}, wantField: "protocolFilter"},
+		{name: "unknown filter type", ruleType: ACLRuleTypeIPv4, destination: &ACLRuleFilter{ACLRuleFilterType: "DOMAINS"}, wantField: "destinationFilter.type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/" {
+					http.Redirect(w, r, "/manage", http.StatusFound)
+					return
+				}
+				calls.Add(1)
+				if r.URL.Path != "/integration/v1/sites/site-1/acl-rules" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"id":"rule-1","type":"IPV4","name":"block"}`))
+			}))
+			defer server.Close()
+
+			client := newTestNetwork(t, server, Config{})
+			resp, err := client.CreateACLRule(context.Background(), &CreateACLRuleRequest{
+				SiteID:            "site-1",
+				ACLRuleType:       tt.ruleType,
+				Name:              "block",
+				Action:            ACLRuleActionBlock,
+				SourceFilter:      tt.source,
+				DestinationFilter: tt.destination,
+				ProtocolFilter:    tt.protocols,
+			})
+
+			if tt.wantField != "" {
+				var validationErr *pkgerrors.ValidationError
+				if !errors.As(err, &validationErr) || validationErr.Field != tt.wantField {
+					t.Fatalf("error = %v, want validation error on %s", err, tt.wantField)
+				}
+				if calls.Load() != 0 {
+					t.Errorf("expected no request, got %d", calls.Load())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp.ID != "rule-1" {
+				t.Errorf("ID = %q, want %q", resp.ID, "rule-1")
+			}
+		})
+	}
+}
+
+// TestNetwork_ReorderACLRules tests the ordering request and the rejection of empty or duplicate IDs.
+func TestNetwork_ReorderACLRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		ids     []string
+		wantErr func(error) bool
+	}{
+		{name: "valid order", ids: []string{"rule-2", "rule-1", "rule-3"}},
+		{name: "duplicate ID", ids: []string{"rule-1", "rule-2", "rule-1"}, wantErr: pkgerrors.IsValidationError},
+		{name: "empty ID", ids: []string{"rule-1", " "}, wantErr: func(err error) bool { return errors.Is(err, pkgerrors.ErrEmptyACLRuleID) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var body map[string]interface{}
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/" {
+					http.Redirect(w, r, "/manage", http.StatusFound)
+					return
+				}
+				calls.Add(1)
+				if r.Method != http.MethodPut || r.URL.Path != "/integration/v1/sites/site-1/acl-rules/ordering" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("failed to decode body: %v", err)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(ACLRuleOrdering{OrderedACLRuleIDs: tt.ids})
+			}))
+			defer server.Close()
+
+			client := newTestNetwork(t, server, Config{})
+			resp, err := client.ReorderACLRules(context.Background(), "site-1", tt.ids)
+
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if calls.Load() != 0 {
+					t.Errorf("expected no request, got %d", calls.Load())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			want := map[string]interface{}{"orderedAclRuleIds": []interface{}{"rule-2", "rule-1", "rule-3"}}
+			if !reflect.DeepEqual(body, want) {
+				t.Errorf("body = %v, want %v", body, want)
+			}
+			if !reflect.DeepEqual(resp.OrderedACLRuleIDs, tt.ids) {
+				t.Errorf("OrderedACLRuleIDs = %v, want %v", resp.OrderedACLRuleIDs, tt.ids)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package network
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// Device states reported by the Network API.
+const (
+	DeviceStateOnline  = "ONLINE"
+	DeviceStateOffline = "OFFLINE"
+)
+
+const (
+	DefaultAdoptionPollInterval = 5 * time.Second
+	DefaultAdoptionTimeout      = 10 * time.Minute
+	DefaultAdoptionConcurrency  = 4
+)
+
+// AdoptPendingDevicesOptions configures a bulk adoption.
+type AdoptPendingDevicesOptions struct {
+	// Filter selects the pending devices to adopt. All supported devices are adopted if nil.
+	Filter func(DevicesPendingAdoptionData) bool
+	// IgnoreDeviceLimit adopts devices even if the site device limit is reached.
+	IgnoreDeviceLimit bool
+	// PollInterval is the interval between device state checks (default: 5s).
+	PollInterval time.Duration
+	// Timeout is the maximum time to wait for each device to come online (default: 10m).
+	Timeout time.Duration
+	// MaxConcurrent is the maximum number of devices adopted at the same time (default: 4).
+	MaxConcurrent int
+}
+
+// AdoptionResult contains the outcome of adopting a single device.
+type AdoptionResult struct {
+	MacAddress string
+	// Device is the last known state of the adopted device, if adoption was accepted.
+	Device *AdoptDevice
+	Err    error
+}
+
+// AdoptPendingDevices adopts every supported pending device matching the filter and waits
+// until each one is online. Up to MaxConcurrent devices are adopted at a time and a result is reported per device;
+// the returned error is only set if the pending devices could not be listed.
+func (n *Network) AdoptPendingDevices(ctx context.Context, siteID string, opts *AdoptPendingDevicesOptions) ([]AdoptionResult, error) {
+	if strings.TrimSpace(siteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	var o AdoptPendingDevicesOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = DefaultAdoptionPollInterval
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = DefaultAdoptionTimeout
+	}
+	if o.MaxConcurrent <= 0 {
+		o.MaxConcurrent = DefaultAdoptionConcurrency
+	}
+
+	pending, err := listAll(func(offset int) ([]DevicesPendingAdoptionData, int, error) {
+		resp, err := n.ListPendingDevices(ctx, &DevicesPendingAdoptionRequest{Offset: offset, Limit: pageLimit})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Data, resp.TotalCount, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var selected []DevicesPendingAdoptionData
+	for _, d := range pending {
+		if d.Supported && (o.Filter == nil || o.Filter(d)) {
+			selected = append(selected, d)
+		}
+	}
+
+	results := make([]AdoptionResult, len(selected))
+	slots := make(chan struct{}, o.MaxConcurrent)
+	var wg sync.WaitGroup
+	for i, d := range selected {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			device, err := n.adoptAndWait(ctx, siteID, d.MacAddress, o)
+			results[i] = AdoptionResult{MacAddress: d.MacAddress, Device: device, Err: err}
+		}()
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// adoptAndWait adopts a device and polls it until it is online or the timeout expires.
+// Polling errors that are expected while a device reprovisions are retried until the timeout.
+func (n *Network) adoptAndWait(ctx context.Context, siteID, macAddress string, o AdoptPendingDevicesOptions) (*AdoptDevice, error) {
+	ctx, cancel := context.WithTimeout(ctx, o.Timeout)
+	defer cancel()
+
+	adopted, err := n.AdoptDevice(ctx, &AdoptDeviceRequest{
+		SiteID:            siteID,
+		MacAddress:        macAddress,
+		IgnoreDeviceLimit: o.IgnoreDeviceLimit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to adopt device %s: %w", macAddress, err)
+	}
+
+	device := &adopted.AdoptDevice
+	ticker := time.NewTicker(o.PollInterval)
+	defer ticker.Stop()
+
+	for device.State != DeviceStateOnline {
+		select {
+		case <-ctx.Done():
+			return device, fmt.Errorf("device %s did not come online (last state %q): %w", macAddress, device.State, ctx.Err())
+		case <-ticker.C:
+		}
+
+		resp, err := n.GetDevice(ctx, &AdoptDeviceDetailRequest{SiteID: siteID, DeviceID: adopted.ID})
+		if err != nil {
+			// A poll cut short by the timeout is reported as the timeout above.
+			if ctx.Err() != nil || isTransientPollError(err) {
+				continue
+			}
+			return device, fmt.Errorf("failed to get device %s: %w", macAddress, err)
+		}
+		device = &resp.AdoptDevice
+	}
+
+	return device, nil
+}
+
+// isTransientPollError reports whether polling an adopted device may succeed later: the device is not
+// listed yet, the controller is busy or unavailable, or the connection timed out or was dropped.
+// Any other error, such as an authentication failure or an invalid response, is permanent.
+func isTransientPollError(err error) bool {
+	var apiErr *errors.APIError
+	if stderrors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusNotFound ||
+			apiErr.StatusCode == http.StatusTooManyRequests ||
+			apiErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	var netErr net.Error
+	if stderrors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return stderrors.Is(err, syscall.ECONNRESET) || stderrors.Is(err, syscall.ECONNREFUSED)
+}
@@ -0,0 +1,229 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	pkgerrors "github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// TestNetwork_AdoptPendingDevices tests bulk adoption with online, timed out, unsupported and rejected devices.
+func TestNetwork_AdoptPendingDevices(t *testing.T) {
+	t.Parallel()
+
+	pending := []DevicesPendingAdoptionData{
+		{MacAddress: "aa:bb:cc:dd:ee:01", Supported: true},
+		{MacAddress: "aa:bb:cc:dd:ee:02", Supported: true},
+		{MacAddress: "aa:bb:cc:dd:ee:03", Supported: false},
+		{MacAddress: "aa:bb:cc:dd:ee:04", Supported: true},
+	}
+	deviceIDs := map[string]string{
+		"aa:bb:cc:dd:ee:01": "dev-1",
+		"aa:bb:cc:dd:ee:02": "dev-2",
+	}
+
+	var (
+		mu          sync.Mutex
+		adopted     []string
+		inFlight    int
+		maxInFlight int
+		polls       = map[string]int{}
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /":
+			w.WriteHeader(http.StatusOK)
+		case "GET /proxy/network/integration/v1/pending-devices":
+			json.NewEncoder(w).Encode(DevicesPendingAdoptionResponse{Count: len(pending), TotalCount: len(pending), Data: pending})
+		case "POST /proxy/network/integration/v1/sites/site-1/devices":
+			var req AdoptDeviceRequest
+			json.NewDecoder(r.Body).Decode(&req)
+
+			mu.Lock()
+			adopted = append(adopted, req.MacAddress)
+			inFlight++
+			maxInFlight = max(maxInFlight, inFlight)
+			mu.Unlock()
+			time.Sleep(10 * time.Millisecond)
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+
+			id, ok := deviceIDs[req.MacAddress]
+			if !ok {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"message":"device limit reached"}`))
+				return
+			}
+			json.NewEncoder(w).Encode(AdoptDevice{ID: id, MacAddress: req.MacAddress, State: "ADOPTING"})
+		case "GET /proxy/network/integration/v1/sites/site-1/devices/dev-1":
+			mu.Lock()
+			polls["dev-1"]++
+			n := polls["dev-1"]
+			mu.Unlock()
+
+			// The device reprovisions: it is briefly unreachable before coming online.
+			switch n {
+			case 1:
+				w.WriteHeader(http.StatusBadGateway)
+			case 2:
+				json.NewEncoder(w).Encode(AdoptDevice{ID: "dev-1", State: "PROVISIONING"})
+			default:
+				json.NewEncoder(w).Encode(AdoptDevice{ID: "dev-1", State: DeviceStateOnline})
+			}
+		case "GET /proxy/network/integration/v1/sites/site-1/devices/dev-2":
+			json.NewEncoder(w).Encode(AdoptDevice{ID: "dev-2", State: "ADOPTING"})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	n := newTestNetwork(t, server, Config{APIKey: "key"})
+
+	results, err := n.AdoptPendingDevices(context.Background(), "site-1", &AdoptPendingDevicesOptions{
+		PollInterval:  5 * time.Millisecond,
+		Timeout:       150 * time.Millisecond,
+		MaxConcurrent: 1,
+	})
+	if err != nil {
+		t.Fatalf("AdoptPendingDevices() error = %v", err)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("results = %+v, want 3 supported devices", results)
+	}
+
+	online := results[0]
+	if online.MacAddress != "aa:bb:cc:dd:ee:01" || online.Err != nil || online.Device == nil || online.Device.State != DeviceStateOnline {
+		t.Errorf("online result = %+v", online)
+	}
+
+	timedOut := results[1]
+	if !errors.Is(timedOut.Err, context.DeadlineExceeded) || timedOut.Device == nil || timedOut.Device.State != "ADOPTING" {
+		t.Errorf("timed out result = %+v", timedOut)
+	}
+
+	var apiErr *pkgerrors.APIError
+	rejected := results[2]
+	if !errors.As(rejected.Err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest || rejected.Device != nil {
+		t.Errorf("rejected result = %+v", rejected)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, mac := range adopted {
+		if mac == "aa:bb:cc:dd:ee:03" {
+			t.Error("unsupported device was adopted")
+		}
+	}
+	if len(adopted) != 3 {
+		t.Errorf("adopted = %v, want 3 devices", adopted)
+	}
+	if maxInFlight != 1 {
+		t.Errorf("concurrent adoptions = %d, want at most 1", maxInFlight)
+	}
+}
+
+// TestNetwork_AdoptPendingDevicesPollErrors tests that permanent polling errors are reported without waiting for the timeout.
+func TestNetwork_AdoptPendingDevicesPollErrors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /":
+			w.WriteHeader(http.StatusOK)
+		case "GET /proxy/network/integration/v1/pending-devices":
+			json.NewEncoder(w).Encode(DevicesPendingAdoptionResponse{Count: 2, TotalCount: 2, Data: []DevicesPendingAdoptionData{
+				{MacAddress: "aa:bb:cc:dd:ee:01", Supported: true},
+				{MacAddress: "aa:bb:cc:dd:ee:02", Supported: true},
+			}})
+		case "POST /proxy/network/integration/v1/sites/site-1/devices":
+			var req AdoptDeviceRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			id := map[string]string{"aa:bb:cc:dd:ee:01": "dev-1", "aa:bb:cc:dd:ee:02": "dev-2"}[req.MacAddress]
+			json.NewEncoder(w).Encode(AdoptDevice{ID: id, MacAddress: req.MacAddress, State: "ADOPTING"})
+		case "GET /proxy/network/integration/v1/sites/site-1/devices/dev-1":
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"message":"invalid API key"}`))
+		case "GET /proxy/network/integration/v1/sites/site-1/devices/dev-2":
+			w.Write([]byte(`{"id": "dev-2",`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	n := newTestNetwork(t, server, Config{APIKey: "key"})
+
+	results, err := n.AdoptPendingDevices(context.Background(), "site-1", &AdoptPendingDevicesOptions{
+		PollInterval: 5 * time.Millisecond,
+		Timeout:      10 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("AdoptPendingDevices() error = %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("results = %+v, want 2 devices", results)
+	}
+
+	if err := results[0].Err; !pkgerrors.IsAuthenticationError(err) {
+		t.Errorf("unauthorized poll error = %v, want authentication error", err)
+	}
+	if err := results[1].Err; err == nil || errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("malformed poll error = %v, want decode error", err)
+	}
+}
+
+// TestNetwork_AdoptPendingDevicesFilter tests that only filtered devices are adopted.
+func TestNetwork_AdoptPendingDevicesFilter(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /":
+			w.WriteHeader(http.StatusOK)
+		case "GET /proxy/network/integration/v1/pending-devices":
+			json.NewEncoder(w).Encode(DevicesPendingAdoptionResponse{Count: 2, TotalCount: 2, Data: []DevicesPendingAdoptionData{
+				{MacAddress: "aa:bb:cc:dd:ee:01", Model: "USW-24", Supported: true},
+				{MacAddress: "aa:bb:cc:dd:ee:02", Model: "U6-Pro", Supported: true},
+			}})
+		case "POST /proxy/network/integration/v1/sites/site-1/devices":
+			var req AdoptDeviceRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.MacAddress != "aa:bb:cc:dd:ee:02" || !req.IgnoreDeviceLimit {
+				t.Errorf("adopt request = %+v", req)
+			}
+			json.NewEncoder(w).Encode(AdoptDevice{ID: "dev-2", State: DeviceStateOnline})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	n := newTestNetwork(t, server, Config{APIKey: "key"})
+
+	results, err := n.AdoptPendingDevices(context.Background(), "site-1", &AdoptPendingDevicesOptions{
+		Filter:            func(d DevicesPendingAdoptionData) bool { return d.Model == "U6-Pro" },
+		IgnoreDeviceLimit: true,
+	})
+	if err != nil {
+		t.Fatalf("AdoptPendingDevices() error = %v", err)
+	}
+	if len(results) != 1 || results[0].Err != nil || results[0].Device.State != DeviceStateOnline {
+		t.Errorf("results = %+v", results)
+	}
+}
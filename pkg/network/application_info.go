@@ -1,7 +1,18 @@
 package network
 
+import "context"
+
 type GetApplicationInfoResponse struct {
 	ApplicationVersion string `json:"applicationVersion"`
 }
 
 // GET /v1/info
+
+// GetApplicationInfo retrieves generic information about the Network application.
+func (n *Network) GetApplicationInfo(ctx context.Context) (*GetApplicationInfoResponse, error) {
+	var resp GetApplicationInfoResponse
+	if err := n.get(ctx, "/v1/info", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,216 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// Credentials contains the credentials used to log in to the controller.
+type Credentials struct {
+	Username string
+	Password string
+	// Token is the two-factor authentication token (optional).
+	Token string
+}
+
+// CredentialsFunc returns the credentials used to renew an expired session.
+type CredentialsFunc func(ctx context.Context) (Credentials, error)
+
+// LoginOption configures a login request.
+type LoginOption func(*loginOptions)
+
+type loginOptions struct {
+	token    string
+	remember bool
+}
+
+// LoginToken sets the two-factor authentication token.
+func LoginToken(token string) LoginOption {
+	return func(o *loginOptions) {
+		o.token = token
+	}
+}
+
+// LoginRememberMe requests a long-lived session.
+func LoginRememberMe() LoginOption {
+	return func(o *loginOptions) {
+		o.remember = true
+	}
+}
+
+// loginRequest is the login payload accepted by both UniFi OS consoles and classic controllers.
+type loginRequest struct {
+	Username     string `json:"username"`
+	Password     string `json:"password"`
+	Token        string `json:"token,omitempty"`
+	UBIC2FAToken string `json:"ubic_2fa_token,omitempty"`
+	RememberMe   bool   `json:"rememberMe,omitempty"`
+	Remember     bool   `json:"remember,omitempty"`
+}
+
+// Login authenticates a username/password session with the controller.
+// UniFi OS consoles are detected automatically and use /api/auth/login,
+// classic controllers use /api/login.
+// The username and password are kept so the session can be renewed when it expires;
+// two-factor tokens are single-use, so renewing a 2FA session requires Config.Credentials.
+func (n *Network) Login(ctx context.Context, username, password string, opts ...LoginOption) error {
+	if username == "" || password == "" {
+		return errors.ErrEmptyCredentials
+	}
+
+	var o loginOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	n.authMu.Lock()
+	defer n.authMu.Unlock()
+
+	if err := n.login(ctx, username, password, o); err != nil {
+		return err
+	}
+
+	n.username = username
+	n.password = password
+	n.remember = o.remember
+	return nil
+}
+
+// login sends the login request. Callers must hold authMu.
+func (n *Network) login(ctx context.Context, username, password string, o loginOptions) error {
+	if err := n.resolvePlatform(ctx); err != nil {
+		return err
+	}
+
+	body := loginRequest{
+		Username: username,
+		Password: password,
+	}
+
+	path := "/api/login"
+	if n.isUDM {
+		path = "/api/auth/login"
+		body.Token = o.token
+		body.RememberMe = o.remember
+	} else {
+		body.UBIC2FAToken = o.token
+		body.Remember = o.remember
+	}
+
+	if err := n.doOnce(ctx, http.MethodPost, path, body, nil); err != nil {
+		return err
+	}
+
+	n.session.Add(1)
+	return nil
+}
+
+// Logout ends the current session and discards the CSRF token and stored credentials.
+// If Config.Credentials is set, a later request will still log in again on demand.
+func (n *Network) Logout(ctx context.Context) error {
+	if err := n.resolvePlatform(ctx); err != nil {
+		return err
+	}
+
+	path := "/api/logout"
+	if n.isUDM {
+		path = "/api/auth/logout"
+	}
+
+	n.authMu.Lock()
+	defer n.authMu.Unlock()
+
+	err := n.doOnce(ctx, http.MethodPost, path, nil, nil)
+	n.setCSRFToken("")
+	n.username = ""
+	n.password = ""
+	n.remember = false
+	n.session.Add(1)
+	return err
+}
+
+// canReauthenticate reports whether an expired session can be renewed.
+func (n *Network) canReauthenticate() bool {
+	if n.apiKey != "" {
+		return false
+	}
+	if n.credentials != nil {
+		return true
+	}
+
+	n.authMu.Lock()
+	defer n.authMu.Unlock()
+	return n.username != ""
+}
+
+// reauthenticate renews the session that was current when a request was rejected.
+// Concurrent callers that observed the same session wait for a single login
+// and then return without logging in again.
+func (n *Network) reauthenticate(ctx context.Context, session uint64) error {
+	n.authMu.Lock()
+	defer n.authMu.Unlock()
+
+	if n.session.Load() != session {
+		return nil
+	}
+
+	var o loginOptions
+	creds := Credentials{Username: n.username, Password: n.password}
+	o.remember = n.remember
+
+	if n.credentials != nil {
+		var err error
+		creds, err = n.credentials(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get credentials: %w", err)
+		}
+		o.token = creds.Token
+	}
+
+	if creds.Username == "" || creds.Password == "" {
+		return errors.ErrEmptyCredentials
+	}
+
+	if err := n.login(ctx, creds.Username, creds.Password, o); err != nil {
+		return fmt.Errorf("failed to renew session: %w", err)
+	}
+	return nil
+}
+
+// setCSRFToken stores the CSRF token attached to mutating requests.
+func (n *Network) setCSRFToken(token string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.csrfToken = token
+}
+
+// getCSRFToken returns the current CSRF token.
+func (n *Network) getCSRFToken() string {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.csrfToken
+}
+
+// updateCSRFToken captures a CSRF token issued or rotated by the controller.
+func (n *Network) updateCSRFToken(header http.Header) {
+	if token := header.Get("X-Updated-CSRF-Token"); token != "" {
+		n.setCSRFToken(token)
+		return
+	}
+	if token := header.Get("X-CSRF-Token"); token != "" {
+		n.setCSRFToken(token)
+	}
+}
+
+// isMutating reports whether the HTTP method changes controller state and requires a CSRF token.
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,328 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	pkgerrors "github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// TestNetwork_Login tests login path selection and payloads for both controller types.
+func TestNetwork_Login(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		unifiOS      bool
+		opts         []LoginOption
+		wantPath     string
+		wantToken    string
+		wantField    string
+		wantRemember string
+	}{
+		{
+			name:         "UniFi OS console",
+			unifiOS:      true,
+			opts:         []LoginOption{LoginToken("123456"), LoginRememberMe()},
+			wantPath:     "/api/auth/login",
+			wantToken:    "123456",
+			wantField:    "token",
+			wantRemember: "rememberMe",
+		},
+		{
+			name:         "classic controller",
+			unifiOS:      false,
+			opts:         []LoginOption{LoginToken("654321"), LoginRememberMe()},
+			wantPath:     "/api/login",
+			wantToken:    "654321",
+			wantField:    "ubic_2fa_token",
+			wantRemember: "remember",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/" {
+					if tt.unifiOS {
+						w.WriteHeader(http.StatusOK)
+					} else {
+						http.Redirect(w, r, "/manage", http.StatusFound)
+					}
+					return
+				}
+				if r.URL.Path != tt.wantPath {
+					t.Errorf("path = %q, want %q", r.URL.Path, tt.wantPath)
+				}
+				var body map[string]interface{}
+				json.NewDecoder(r.Body).Decode(&body)
+				if body["username"] != "admin" || body["password"] != "pass" {
+					t.Errorf("credentials = %v/%v, want admin/pass", body["username"], body["password"])
+				}
+				if body[tt.wantField] != tt.wantToken {
+					t.Errorf("%s = %v, want %q", tt.wantField, body[tt.wantField], tt.wantToken)
+				}
+				if body[tt.wantRemember] != true {
+					t.Errorf("%s = %v, want true", tt.wantRemember, body[tt.wantRemember])
+				}
+			}))
+			defer server.Close()
+
+			n := newTestNetwork(t, server, Config{})
+			if err := n.Login(context.Background(), "admin", "pass", tt.opts...); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if n.isUDM != tt.unifiOS {
+				t.Errorf("isUDM = %v, want %v", n.isUDM, tt.unifiOS)
+			}
+		})
+	}
+}
+
+// TestNetwork_LoginEmptyCredentials tests that empty credentials are rejected.
+func TestNetwork_LoginEmptyCredentials(t *testing.T) {
+	t.Parallel()
+
+	n, err := New(Config{BaseURL: "https://192.168.1.1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := n.Login(context.Background(), "admin", ""); !errors.Is(err, pkgerrors.ErrEmptyCredentials) {
+		t.Errorf("error = %v, want %v", err, pkgerrors.ErrEmptyCredentials)
+	}
+}
+
+// TestNetwork_CSRFToken tests that CSRF tokens are captured, rotated and attached to mutating requests.
+func TestNetwork_CSRFToken(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			w.WriteHeader(http.StatusOK)
+		case "/api/auth/login":
+			w.Header().Set("X-CSRF-Token", "token-1")
+		case "/proxy/network/integration/v1/info":
+			if got := r.Header.Get("X-CSRF-Token"); got != "" {
+				t.Errorf("GET request carried X-CSRF-Token %q", got)
+			}
+			w.Header().Set("X-Updated-CSRF-Token", "token-2")
+			w.Write([]byte(`{}`))
+		case "/api/auth/logout":
+			if got := r.Header.Get("X-CSRF-Token"); got != "token-2" {
+				t.Errorf("X-CSRF-Token = %q, want %q", got, "token-2")
+			}
+		default:
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	n := newTestNetwork(t, server, Config{})
+	ctx := context.Background()
+
+	if err := n.Login(ctx, "admin", "pass"); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if got := n.getCSRFToken(); got != "token-1" {
+		t.Errorf("csrfToken = %q, want %q", got, "token-1")
+	}
+	if _, err := n.GetApplicationInfo(ctx); err != nil {
+		t.Fatalf("GetApplicationInfo() error = %v", err)
+	}
+	if err := n.Logout(ctx); err != nil {
+		t.Fatalf("Logout() error = %v", err)
+	}
+	if got := n.getCSRFToken(); got != "" {
+		t.Errorf("csrfToken = %q after logout, want empty", got)
+	}
+}
+
+// TestNetwork_Logger tests that requests are logged without the password, session cookie or CSRF token.
+func TestNetwork_Logger(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			w.WriteHeader(http.StatusOK)
+		case "/api/auth/login":
+			http.SetCookie(w, &http.Cookie{Name: "TOKEN", Value: "session-cookie"})
+			w.Header().Set("X-CSRF-Token", "csrf-token")
+		case "/api/auth/logout":
+			w.Header().Set("X-Request-Id", "req-1")
+		}
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	n := newTestNetwork(t, server, Config{Logger: logger})
+	ctx := context.Background()
+
+	if err := n.Login(ctx, "admin", "pass-s3cret"); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if err := n.Logout(ctx); err != nil {
+		t.Fatalf("Logout() error = %v", err)
+	}
+
+	logs := buf.String()
+	for _, secret := range []string{"pass-s3cret", "session-cookie", "csrf-token"} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("logs leak %q:\n%s", secret, logs)
+		}
+	}
+	if !strings.Contains(logs, `"path":"/api/auth/login"`) || !strings.Contains(logs, `"request_id":"req-1"`) {
+		t.Errorf("logs are missing requests:\n%s", logs)
+	}
+}
+
+// TestNetwork_Reauthenticate tests that concurrent requests renew an expired session with a single login.
+func TestNetwork_Reauthenticate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu     sync.Mutex
+		valid  = "session-1"
+		logins atomic.Int32
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.URL.Path {
+		case "/":
+			w.WriteHeader(http.StatusOK)
+		case "/api/auth/login":
+			logins.Add(1)
+			var body Credentials
+			json.NewDecoder(r.Body).Decode(&body)
+			if body.Username != "admin" || body.Password != "pass" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			http.SetCookie(w, &http.Cookie{Name: "TOKEN", Value: valid, Path: "/"})
+			w.Header().Set("X-CSRF-Token", "csrf-"+valid)
+		default:
+			cookie, err := r.Cookie("TOKEN")
+			if err != nil || cookie.Value != valid {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte(`{"applicationVersion":"9.1.120"}`))
+		}
+	}))
+	defer server.Close()
+
+	n := newTestNetwork(t, server, Config{})
+	ctx := context.Background()
+
+	if err := n.Login(ctx, "admin", "pass"); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+
+	// Expire the session on the controller side.
+	mu.Lock()
+	valid = "session-2"
+	mu.Unlock()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 50)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := n.GetApplicationInfo(ctx)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("GetApplicationInfo() error = %v", err)
+		}
+	}
+	if got := logins.Load(); got != 2 {
+		t.Errorf("logins = %d, want 2", got)
+	}
+	if got := n.getCSRFToken(); got != "csrf-session-2" {
+		t.Errorf("csrfToken = %q, want %q", got, "csrf-session-2")
+	}
+}
+
+// TestNetwork_ReauthenticateWithCallback tests session renewal through Config.Credentials.
+func TestNetwork_ReauthenticateWithCallback(t *testing.T) {
+	t.Parallel()
+
+	var loggedIn atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			http.Redirect(w, r, "/manage", http.StatusFound)
+		case "/api/login":
+			var body loginRequest
+			json.NewDecoder(r.Body).Decode(&body)
+			if body.UBIC2FAToken != "000111" {
+				t.Errorf("ubic_2fa_token = %q, want %q", body.UBIC2FAToken, "000111")
+			}
+			loggedIn.Store(true)
+		default:
+			if !loggedIn.Load() {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	n := newTestNetwork(t, server, Config{
+		Credentials: func(ctx context.Context) (Credentials, error) {
+			return Credentials{Username: "admin", Password: "pass", Token: "000111"}, nil
+		},
+	})
+
+	if _, err := n.GetApplicationInfo(context.Background()); err != nil {
+		t.Fatalf("GetApplicationInfo() error = %v", err)
+	}
+	if !loggedIn.Load() {
+		t.Error("expected login through credentials callback")
+	}
+}
+
+// TestNetwork_NoReauthenticateWithAPIKey tests that API key clients surface 401 errors directly.
+func TestNetwork_NoReauthenticateWithAPIKey(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/auth/login" {
+			t.Error("unexpected login for API key client")
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	n := newTestNetwork(t, server, Config{APIKey: "bad-key"})
+	if _, err := n.GetApplicationInfo(context.Background()); !pkgerrors.IsAuthenticationError(err) {
+		t.Errorf("error = %v, want authentication error", err)
+	}
+}
@@ -0,0 +1,221 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ClientRecord is a client known to a site, merging its connection state with its history.
+type ClientRecord struct {
+	// ID is the classic API user ID, used by client management calls.
+	ID  string
+	MAC string
+	// Name is the alias given in the controller; Hostname is the name reported by the client.
+	Name     string
+	Hostname string
+	OUI      string
+	// IP is the current address of connected clients and the last known address otherwise.
+	IP        string
+	FixedIP   string
+	NetworkID string
+	Connected bool
+	Wired     bool
+	Guest     bool
+	Blocked   bool
+	// ESSID and UplinkMAC (access point or switch) are only set for connected clients.
+	ESSID     string
+	UplinkMAC string
+	FirstSeen time.Time
+	LastSeen  time.Time
+}
+
+// DisplayName returns the alias of the client, falling back to its hostname and MAC address.
+func (r ClientRecord) DisplayName() string {
+	switch {
+	case r.Name != "":
+		return r.Name
+	case r.Hostname != "":
+		return r.Hostname
+	default:
+		return r.MAC
+	}
+}
+
+// ClientInventory is a snapshot of the connected and historical clients of a site.
+type ClientInventory struct {
+	records []ClientRecord
+	byMAC   map[string]int
+}
+
+// ClientInventory loads the connected (stat/sta) and known (stat/alluser) clients of the site.
+func (l *Legacy) ClientInventory(ctx context.Context) (*ClientInventory, error) {
+	known, err := l.ListKnownClients(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list known clients: %w", err)
+	}
+	active, err := l.ListActiveClients(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active clients: %w", err)
+	}
+	return newClientInventory(known, active), nil
+}
+
+// FindClient looks up a client of the site by MAC address, IP address or name; see ClientInventory.Find.
+func (l *Legacy) FindClient(ctx context.Context, query string) ([]ClientRecord, error) {
+	inventory, err := l.ClientInventory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return inventory.Find(query), nil
+}
+
+func newClientInventory(known, active []LegacyClient) *ClientInventory {
+	inv := &ClientInventory{byMAC: make(map[string]int, len(known))}
+
+	for _, client := range known {
+		inv.merge(client, false)
+	}
+	for _, client := range active {
+		inv.merge(client, true)
+	}
+
+	// Connected clients first, then the most recently seen.
+	sort.SliceStable(inv.records, func(i, j int) bool {
+		a, b := inv.records[i], inv.records[j]
+		if a.Connected != b.Connected {
+			return a.Connected
+		}
+		return a.LastSeen.After(b.LastSeen)
+	})
+	for i, record := range inv.records {
+		inv.byMAC[record.MAC] = i
+	}
+	return inv
+}
+
+// merge folds a classic client entry into the record with the same MAC address.
+func (inv *ClientInventory) merge(client LegacyClient, connected bool) {
+	mac, err := NormalizeMAC(client.MAC)
+	if err != nil {
+		return
+	}
+
+	i, ok := inv.byMAC[mac]
+	if !ok {
+		inv.records = append(inv.records, ClientRecord{MAC: mac})
+		i = len(inv.records) - 1
+		inv.byMAC[mac] = i
+	}
+	r := &inv.records[i]
+
+	r.ID = firstNonEmpty(r.ID, client.ID)
+	r.Name = firstNonEmpty(client.Name, r.Name)
+	r.Hostname = firstNonEmpty(client.Hostname, r.Hostname)
+	r.OUI = firstNonEmpty(client.OUI, r.OUI)
+	r.IP = firstNonEmpty(client.IP, client.LastIP, r.IP)
+	r.NetworkID = firstNonEmpty(client.NetworkID, r.NetworkID)
+	r.Wired = client.IsWired
+	r.Guest = r.Guest || client.IsGuest
+	r.Blocked = r.Blocked || client.Blocked
+	if client.UseFixedIP {
+		r.FixedIP = client.FixedIP
+	}
+
+	if connected {
+		r.Connected = true
+		r.ESSID = client.ESSID
+		r.UplinkMAC = firstNonEmpty(client.APMAC, client.SwitchMAC)
+	}
+
+	if client.FirstSeen > 0 {
+		if seen := time.Unix(client.FirstSeen, 0); r.FirstSeen.IsZero() || seen.Before(r.FirstSeen) {
+			r.FirstSeen = seen
+		}
+	}
+	if client.LastSeen > 0 {
+		if seen := time.Unix(client.LastSeen, 0); seen.After(r.LastSeen) {
+			r.LastSeen = seen
+		}
+	}
+}
+
+// Records returns every client, connected clients first and then by most recently seen.
+func (inv *ClientInventory) Records() []ClientRecord {
+	return inv.records
+}
+
+// ByMAC looks up a client by MAC address in any common notation.
+func (inv *ClientInventory) ByMAC(mac string) (ClientRecord, bool) {
+	normalized, err := NormalizeMAC(mac)
+	if err != nil {
+		return ClientRecord{}, false
+	}
+	i, ok := inv.byMAC[normalized]
+	if !ok {
+		return ClientRecord{}, false
+	}
+	return inv.records[i], true
+}
+
+// ByIP returns the clients that currently use or last used the given IP address or fixed IP.
+func (inv *ClientInventory) ByIP(ip string) []ClientRecord {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return nil
+	}
+	return inv.filter(func(r ClientRecord) bool {
+		return sameAddr(r.IP, addr) || sameAddr(r.FixedIP, addr)
+	})
+}
+
+// ByName returns the clients whose alias or hostname contains name, ignoring case.
+func (inv *ClientInventory) ByName(name string) []ClientRecord {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return nil
+	}
+	return inv.filter(func(r ClientRecord) bool {
+		return strings.Contains(strings.ToLower(r.Name), name) || strings.Contains(strings.ToLower(r.Hostname), name)
+	})
+}
+
+// Find looks up clients by IP address if query is one, by MAC address if query is one, and by name otherwise.
+// A query that looks like a MAC address but matches no client, such as the hostname "deadbeefcafe",
+// is also looked up by name.
+func (inv *ClientInventory) Find(query string) []ClientRecord {
+	if _, err := netip.ParseAddr(strings.TrimSpace(query)); err == nil {
+		return inv.ByIP(query)
+	}
+	if record, ok := inv.ByMAC(query); ok {
+		return []ClientRecord{record}
+	}
+	return inv.ByName(query)
+}
+
+func (inv *ClientInventory) filter(match func(ClientRecord) bool) []ClientRecord {
+	var matches []ClientRecord
+	for _, record := range inv.records {
+		if match(record) {
+			matches = append(matches, record)
+		}
+	}
+	return matches
+}
+
+func sameAddr(s string, addr netip.Addr) bool {
+	parsed, err := netip.ParseAddr(s)
+	return err == nil && parsed == addr
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
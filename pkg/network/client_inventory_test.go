@@ -0,0 +1,97 @@
+package network
+
+import (
+	"testing"
+	"time"
+)
+
+// TestNormalizeMAC tests MAC address normalization.
+func TestNormalizeMAC(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		mac     string
+		want    string
+		wantErr bool
+	}{
+		{mac: "AA:BB:CC:DD:EE:FF", want: "aa:bb:cc:dd:ee:ff"},
+		{mac: "aa-bb-cc-dd-ee-ff", want: "aa:bb:cc:dd:ee:ff"},
+		{mac: "aabb.ccdd.eeff", want: "aa:bb:cc:dd:ee:ff"},
+		{mac: " AABBCCDDEEFF ", want: "aa:bb:cc:dd:ee:ff"},
+		{mac: "aa:bb:cc:dd:ee", wantErr: true},
+		{mac: "gg:bb:cc:dd:ee:ff", wantErr: true},
+		{mac: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := NormalizeMAC(tt.mac)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("NormalizeMAC(%q) error = %v, wantErr %v", tt.mac, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("NormalizeMAC(%q) = %q, want %q", tt.mac, got, tt.want)
+		}
+	}
+}
+
+// TestClientInventory tests merging connected and historical clients and looking them up.
+func TestClientInventory(t *testing.T) {
+	t.Parallel()
+
+	known := []LegacyClient{
+		{ID: "u1", MAC: "AA:BB:CC:DD:EE:01", Name: "Alice laptop", Hostname: "alice-mbp", FirstSeen: 1000, LastSeen: 5000, LastIP: "10.0.0.50"},
+		{ID: "u2", MAC: "aa:bb:cc:dd:ee:02", Hostname: "printer", FirstSeen: 2000, LastSeen: 3000, UseFixedIP: true, FixedIP: "10.0.0.9"},
+		{ID: "u3", MAC: "aa:bb:cc:dd:ee:03", Hostname: "old-phone", FirstSeen: 100, LastSeen: 200, Blocked: true},
+		{ID: "u4", MAC: "aa:bb:cc:dd:ee:04", Hostname: "deadbeefcafe", FirstSeen: 50, LastSeen: 100},
+	}
+	active := []LegacyClient{
+		{MAC: "aa:bb:cc:dd:ee:01", IP: "10.0.0.51", ESSID: "Office", APMAC: "11:22:33:44:55:66", LastSeen: 9000},
+	}
+
+	inv := newClientInventory(known, active)
+
+	records := inv.Records()
+	if len(records) != 4 {
+		t.Fatalf("Records() = %d entries, want 4", len(records))
+	}
+	if records[0].MAC != "aa:bb:cc:dd:ee:01" || records[1].MAC != "aa:bb:cc:dd:ee:02" {
+		t.Errorf("Records() order = %s, %s", records[0].MAC, records[1].MAC)
+	}
+
+	alice, ok := inv.ByMAC("AABBCCDDEE01")
+	if !ok {
+		t.Fatal("ByMAC() did not find the laptop")
+	}
+	if !alice.Connected || alice.ID != "u1" || alice.IP != "10.0.0.51" || alice.UplinkMAC != "11:22:33:44:55:66" {
+		t.Errorf("ByMAC() = %+v", alice)
+	}
+	if !alice.FirstSeen.Equal(time.Unix(1000, 0)) || !alice.LastSeen.Equal(time.Unix(9000, 0)) {
+		t.Errorf("seen = %v - %v", alice.FirstSeen, alice.LastSeen)
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "aa-bb-cc-dd-ee-03", want: []string{"aa:bb:cc:dd:ee:03"}},
+		{query: "10.0.0.9", want: []string{"aa:bb:cc:dd:ee:02"}},
+		{query: "10.0.0.51", want: []string{"aa:bb:cc:dd:ee:01"}},
+		{query: "ALICE", want: []string{"aa:bb:cc:dd:ee:01"}},
+		{query: "phone", want: []string{"aa:bb:cc:dd:ee:03"}},
+		{query: "deadbeefcafe", want: []string{"aa:bb:cc:dd:ee:04"}},
+		{query: "aa:bb:cc:dd:ee:99"},
+	}
+	for _, tt := range tests {
+		got := inv.Find(tt.query)
+		if len(got) != len(tt.want) {
+			t.Errorf("Find(%q) = %d records, want %d", tt.query, len(got), len(tt.want))
+			continue
+		}
+		for i := range got {
+			if got[i].MAC != tt.want[i] {
+				t.Errorf("Find(%q)[%d] = %s, want %s", tt.query, i, got[i].MAC, tt.want[i])
+			}
+		}
+	}
+}
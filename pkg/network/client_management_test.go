@@ -0,0 +1,95 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pkgerrors "github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// TestNetwork_ClientManagement tests the client management commands sent through the classic API.
+func TestNetwork_ClientManagement(t *testing.T) {
+	t.Parallel()
+
+	var commands []clientCommandRequest
+	var updates []map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/":
+			http.Redirect(w, r, "/manage", http.StatusFound)
+		case "/api/s/default/cmd/stamgr":
+			var cmd clientCommandRequest
+			if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+				t.Errorf("failed to decode body: %v", err)
+			}
+			commands = append(commands, cmd)
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+		case "/api/s/default/stat/user/aa:bb:cc:dd:ee:ff":
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"user-1","mac":"aa:bb:cc:dd:ee:ff"}]}`))
+		case "/api/s/default/stat/user/aa:bb:cc:dd:ee:00":
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+		case "/api/s/default/rest/user/user-1":
+			if r.Method != http.MethodPut {
+				t.Errorf("method = %s, want PUT", r.Method)
+			}
+			var update map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				t.Errorf("failed to decode body: %v", err)
+			}
+			updates = append(updates, update)
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"user-1","mac":"aa:bb:cc:dd:ee:ff","name":"Lobby TV"}]}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	n := newTestNetwork(t, server, Config{})
+
+	if err := n.BlockClient(ctx, "AA-BB-CC-DD-EE-FF"); err != nil {
+		t.Fatalf("BlockClient() error = %v", err)
+	}
+	if err := n.ForgetClients(ctx, "aabbccddeeff", "aa:bb:cc:dd:ee:00"); err != nil {
+		t.Fatalf("ForgetClients() error = %v", err)
+	}
+	if len(commands) != 2 || commands[0].Command != ClientCommandBlock || commands[0].MAC != "aa:bb:cc:dd:ee:ff" {
+		t.Errorf("commands = %+v", commands)
+	} else if len(commands[1].MACs) != 2 || commands[1].MACs[0] != "aa:bb:cc:dd:ee:ff" {
+		t.Errorf("forget command = %+v", commands[1])
+	}
+
+	client, err := n.RenameClient(ctx, "aa:bb:cc:dd:ee:ff", "Lobby TV")
+	if err != nil {
+		t.Fatalf("RenameClient() error = %v", err)
+	}
+	if client.Name != "Lobby TV" {
+		t.Errorf("Name = %q, want %q", client.Name, "Lobby TV")
+	}
+
+	if _, err := n.SetClientFixedIP(ctx, "aa:bb:cc:dd:ee:ff", "net-1", "10.0.0.20"); err != nil {
+		t.Fatalf("SetClientFixedIP() error = %v", err)
+	}
+	if len(updates) != 2 || updates[0]["name"] != "Lobby TV" || updates[1]["fixed_ip"] != "10.0.0.20" || updates[1]["use_fixedip"] != true {
+		t.Errorf("updates = %+v", updates)
+	} else if _, ok := updates[1]["name"]; ok {
+		t.Errorf("fixed IP update should not change the name: %+v", updates[1])
+	}
+
+	if _, err := n.SetClientFixedIP(ctx, "aa:bb:cc:dd:ee:ff", "net-1", "10.0.0"); !pkgerrors.IsValidationError(err) {
+		t.Errorf("SetClientFixedIP() error = %v, want validation error", err)
+	}
+	if err := n.ReconnectClient(ctx, "not-a-mac"); !pkgerrors.IsValidationError(err) {
+		t.Errorf("ReconnectClient() error = %v, want validation error", err)
+	}
+	if _, err := n.RenameClient(ctx, "aa:bb:cc:dd:ee:00", "Unknown"); !errors.Is(err, pkgerrors.ErrClientNotFound) {
+		t.Errorf("RenameClient() error = %v, want ErrClientNotFound", err)
+	}
+}
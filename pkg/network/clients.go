@@ -1,5 +1,13 @@
 package network
 
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
 type ExecuteClientAction string
 
 const (
@@ -8,8 +16,8 @@ const (
 )
 
 type ExecuteClientActionRequest struct {
-	ClientID string `json:"clientId"`
-	SiteID   string `json:"siteId"`
+	ClientID string `json:"-"`
+	SiteID   string `json:"-"`
 
 	Action               ExecuteClientAction `json:"action"`
 	TimeLimitMinutes     int                 `json:"timeLimitMinutes,omitempty"`
@@ -19,7 +27,7 @@ type ExecuteClientActionRequest struct {
 }
 
 type ExecuteClientActionResponse struct {
-	Action ExecuteClientAction `josn:"action"`
+	Action ExecuteClientAction `json:"action"`
 
 	RevokedAuthorization ClientActionAuthorization `json:"revokedAuthorization,omitempty"`
 	GrantedAuthorization ClientActionAuthorization `json:"grantedAuthorization,omitempty"`
@@ -27,6 +35,26 @@ type ExecuteClientActionResponse struct {
 
 // POST /v1/sites/{siteId}/clients/{clientId}/actions
 
+// ExecuteClientAction performs an action on a connected client.
+// Blocking, reconnecting, renaming and fixed IPs are not available in the integration API;
+// use BlockClient, ReconnectClient, RenameClient, SetClientFixedIP and the related methods instead.
+func (n *Network) ExecuteClientAction(ctx context.Context, req *ExecuteClientActionRequest) (*ExecuteClientActionResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.ClientID) == "" {
+		return nil, errors.ErrEmptyClientID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/clients/%s/actions", req.SiteID, req.ClientID)
+
+	var resp ExecuteClientActionResponse
+	if err := n.post(ctx, path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 type ConnectedClientsRequest struct {
 	SiteID string `json:"siteId"`
 
@@ -35,6 +63,14 @@ type ConnectedClientsRequest struct {
 	Filter string `json:"filter"`
 }
 
+// ToQuery converts the request to URL query string.
+func (r *ConnectedClientsRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
 type ConnectedClientsResponse struct {
 	Offset     int               `json:"offset"`
 	Limit      int               `json:"limit"`
@@ -45,6 +81,21 @@ type ConnectedClientsResponse struct {
 
 // GET /v1/sites/{siteId}/clients
 
+// ListClients retrieves the clients currently connected to a site.
+func (n *Network) ListClients(ctx context.Context, req *ConnectedClientsRequest) (*ConnectedClientsResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/clients", req.SiteID) + req.ToQuery()
+
+	var resp ConnectedClientsResponse
+	if err := n.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 type ConnectedClientDetailsRequest struct {
 	ClientID string `json:"clientId"`
 	SiteID   string `json:"siteId"`
@@ -55,3 +106,19 @@ type ConnectedClientDetailsResponse struct {
 }
 
 // GET /v1/sites/{siteId}/clients/{clientId}
+
+// GetClient retrieves the details of a single connected client.
+func (n *Network) GetClient(ctx context.Context, req *ConnectedClientDetailsRequest) (*ConnectedClientDetailsResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.ClientID) == "" {
+		return nil, errors.ErrEmptyClientID
+	}
+
+	var resp ConnectedClientDetailsResponse
+	if err := n.get(ctx, fmt.Sprintf("/v1/sites/%s/clients/%s", req.SiteID, req.ClientID), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,161 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+type ListDNSPoliciesRequest struct {
+	SiteID string `json:"siteId"`
+
+	Offset int    `json:"offset"`
+	Limit  int    `json:"limit"`
+	Filter string `json:"filter"`
+}
+
+// ToQuery converts the request to URL query string.
+func (r *ListDNSPoliciesRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
+type ListDNSPoliciesResponse struct {
+	Offset     int         `json:"offset"`
+	Limit      int         `json:"limit"`
+	Count      int         `json:"count"`
+	TotalCount int         `json:"totalCount"`
+	Data       []DNSPolicy `json:"data"`
+}
+
+// GET /v1/sites/{siteId}/dns/policies
+
+// ListDNSPolicies retrieves the DNS policies of a site.
+func (n *Network) ListDNSPolicies(ctx context.Context, req *ListDNSPoliciesRequest) (*ListDNSPoliciesResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/dns/policies", req.SiteID) + req.ToQuery()
+
+	var resp ListDNSPoliciesResponse
+	if err := n.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type DNSPolicyDetailsRequest struct {
+	DNSPolicyID string `json:"dnsPolicyId"`
+	SiteID      string `json:"siteId"`
+}
+
+type DNSPolicyDetailsResponse struct {
+	DNSPolicy
+}
+
+// GET /v1/sites/{siteId}/dns/policies/{dnsPolicyId}
+
+// GetDNSPolicy retrieves a single DNS policy.
+func (n *Network) GetDNSPolicy(ctx context.Context, req *DNSPolicyDetailsRequest) (*DNSPolicyDetailsResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.DNSPolicyID) == "" {
+		return nil, errors.ErrEmptyDNSPolicyID
+	}
+
+	var resp DNSPolicyDetailsResponse
+	if err := n.get(ctx, fmt.Sprintf("/v1/sites/%s/dns/policies/%s", req.SiteID, req.DNSPolicyID), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type CreateDNSPolicyRequest struct {
+	SiteID string `json:"-"`
+
+	DNSPolicy
+}
+
+type CreateDNSPolicyResponse struct {
+	DNSPolicy
+}
+
+// POST /v1/sites/{siteId}/dns/policies
+
+// CreateDNSPolicy creates a new DNS policy.
+func (n *Network) CreateDNSPolicy(ctx context.Context, req *CreateDNSPolicyRequest) (*CreateDNSPolicyResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.Domain) == "" {
+		return nil, errors.NewValidationError("domain", "cannot be empty")
+	}
+
+	body := req.DNSPolicy
+	body.ID = ""
+	body.Metadata = nil
+
+	var resp CreateDNSPolicyResponse
+	if err := n.post(ctx, fmt.Sprintf("/v1/sites/%s/dns/policies", req.SiteID), body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type UpdateDNSPolicyRequest struct {
+	DNSPolicyID string `json:"-"`
+	SiteID      string `json:"-"`
+
+	DNSPolicy
+}
+
+type UpdateDNSPolicyResponse struct {
+	DNSPolicy
+}
+
+// PUT /v1/sites/{siteId}/dns/policies/{dnsPolicyId}
+
+// UpdateDNSPolicy replaces a DNS policy.
+func (n *Network) UpdateDNSPolicy(ctx context.Context, req *UpdateDNSPolicyRequest) (*UpdateDNSPolicyResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.DNSPolicyID) == "" {
+		return nil, errors.ErrEmptyDNSPolicyID
+	}
+
+	body := req.DNSPolicy
+	body.ID = ""
+	body.Metadata = nil
+
+	var resp UpdateDNSPolicyResponse
+	if err := n.put(ctx, fmt.Sprintf("/v1/sites/%s/dns/policies/%s", req.SiteID, req.DNSPolicyID), body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type DeleteDNSPolicyRequest struct {
+	DNSPolicyID string `json:"dnsPolicyId"`
+	SiteID      string `json:"siteId"`
+}
+
+// DELETE /v1/sites/{siteId}/dns/policies/{dnsPolicyId}
+
+// DeleteDNSPolicy deletes a DNS policy.
+func (n *Network) DeleteDNSPolicy(ctx context.Context, req *DeleteDNSPolicyRequest) error {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.DNSPolicyID) == "" {
+		return errors.ErrEmptyDNSPolicyID
+	}
+
+	return n.delete(ctx, fmt.Sprintf("/v1/sites/%s/dns/policies/%s", req.SiteID, req.DNSPolicyID), nil)
+}
@@ -0,0 +1,457 @@
+package network
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// zoneRecordTypes maps the zone file record types that can be synchronized to DNS policy types.
+var zoneRecordTypes = map[string]DNSPolicyType{
+	"A":     DNSPolicyTypeA,
+	"AAAA":  DNSPolicyTypeAAAA,
+	"CNAME": DNSPolicyTypeCNAME,
+	"MX":    DNSPolicyTypeMX,
+	"TXT":   DNSPolicyTypeTXT,
+	"SRV":   DNSPolicyTypeSRV,
+}
+
+// ignoredZoneRecordTypes are zone file record types that describe the zone itself and are skipped.
+var ignoredZoneRecordTypes = map[string]bool{
+	"SOA": true,
+	"NS":  true,
+}
+
+// ParseZoneFile parses A, AAAA, CNAME, MX, TXT and SRV records from a BIND-style zone file.
+// Relative names are qualified with origin unless the file sets its own $ORIGIN.
+// SOA and NS records are skipped, and every parsed record is enabled.
+// TTLs may use BIND unit suffixes (e.g. "1h30m"); records without a TTL or $TTL have TTLSeconds 0,
+// which PlanDNSSync treats as "keep the existing TTL".
+func ParseZoneFile(r io.Reader, origin string) ([]DNSPolicy, error) {
+	origin = strings.TrimSuffix(origin, ".")
+	defaultTTL := 0
+	lastName := ""
+
+	var policies []DNSPolicy
+	lines, err := zoneLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range lines {
+		fields := splitZoneFields(line.text)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(fields[0]) {
+		case "$ORIGIN":
+			if len(fields) < 2 {
+				return nil, zoneError(line.number, "$ORIGIN requires a domain")
+			}
+			origin = strings.TrimSuffix(fields[1], ".")
+			continue
+		case "$TTL":
+			if len(fields) < 2 {
+				return nil, zoneError(line.number, "$TTL requires a value")
+			}
+			ttl, err := parseZoneTTL(fields[1])
+			if err != nil {
+				return nil, zoneError(line.number, fmt.Sprintf("invalid $TTL %q", fields[1]))
+			}
+			defaultTTL = ttl
+			continue
+		}
+
+		// A line starting with whitespace reuses the previous owner name.
+		name := lastName
+		if !line.continued {
+			name = qualifyZoneName(fields[0], origin)
+			fields = fields[1:]
+		}
+		if name == "" {
+			return nil, zoneError(line.number, "record has no owner name")
+		}
+		lastName = name
+
+		ttl := defaultTTL
+		for len(fields) > 0 {
+			if v, err := parseZoneTTL(fields[0]); err == nil {
+				ttl = v
+			} else if !strings.EqualFold(fields[0], "IN") {
+				break
+			}
+			fields = fields[1:]
+		}
+		if len(fields) == 0 {
+			return nil, zoneError(line.number, "record has no type")
+		}
+
+		recordType := strings.ToUpper(fields[0])
+		rdata := fields[1:]
+		if ignoredZoneRecordTypes[recordType] {
+			continue
+		}
+		policyType, ok := zoneRecordTypes[recordType]
+		if !ok {
+			return nil, zoneError(line.number, fmt.Sprintf("unsupported record type %q", recordType))
+		}
+
+		policy := DNSPolicy{
+			DNSPolicyType: policyType,
+			Enabled:       true,
+			Domain:        name,
+			TTLSeconds:    ttl,
+		}
+		if err := policy.setZoneData(rdata, origin); err != nil {
+			return nil, zoneError(line.number, err.Error())
+		}
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+// setZoneData fills the record data of a policy from the zone file rdata fields.
+func (p *DNSPolicy) setZoneData(rdata []string, origin string) error {
+	want := map[DNSPolicyType]int{
+		DNSPolicyTypeA:     1,
+		DNSPolicyTypeAAAA:  1,
+		DNSPolicyTypeCNAME: 1,
+		DNSPolicyTypeMX:    2,
+		DNSPolicyTypeTXT:   1,
+		DNSPolicyTypeSRV:   4,
+	}[p.DNSPolicyType]
+	if len(rdata) < want {
+		return fmt.Errorf("%s record requires %d data fields, got %d", p.DNSPolicyType, want, len(rdata))
+	}
+
+	var err error
+	switch p.DNSPolicyType {
+	case DNSPolicyTypeA:
+		p.IPv4Address = rdata[0]
+	case DNSPolicyTypeAAAA:
+		p.IPv6Address = rdata[0]
+	case DNSPolicyTypeCNAME:
+		p.TargetDomain = qualifyZoneName(rdata[0], origin)
+	case DNSPolicyTypeMX:
+		if p.Priority, err = strconv.Atoi(rdata[0]); err != nil {
+			return fmt.Errorf("invalid MX priority %q", rdata[0])
+		}
+		p.MailServerDomain = qualifyZoneName(rdata[1], origin)
+	case DNSPolicyTypeTXT:
+		p.Text = strings.Join(rdata, "")
+	case DNSPolicyTypeSRV:
+		values := make([]int, 3)
+		for i := range values {
+			if values[i], err = strconv.Atoi(rdata[i]); err != nil {
+				return fmt.Errorf("invalid SRV value %q", rdata[i])
+			}
+		}
+		p.Priority, p.Weight, p.Port = values[0], values[1], values[2]
+		p.ServerDomain = qualifyZoneName(rdata[3], origin)
+
+		// SRV owner names have the form _service._protocol.domain.
+		labels := strings.SplitN(p.Domain, ".", 3)
+		if len(labels) == 3 && strings.HasPrefix(labels[0], "_") && strings.HasPrefix(labels[1], "_") {
+			p.Service, p.Protocol, p.Domain = labels[0], labels[1], labels[2]
+		}
+	}
+	return nil
+}
+
+// zoneTTLUnits are the BIND TTL unit suffixes, in seconds.
+var zoneTTLUnits = map[byte]int{
+	's': 1,
+	'm': 60,
+	'h': 60 * 60,
+	'd': 24 * 60 * 60,
+	'w': 7 * 24 * 60 * 60,
+}
+
+// parseZoneTTL parses a TTL given in seconds or with BIND unit suffixes, such as "1h" or "1d12h".
+func parseZoneTTL(value string) (int, error) {
+	if ttl, err := strconv.Atoi(value); err == nil {
+		if ttl < 0 {
+			return 0, fmt.Errorf("negative TTL %q", value)
+		}
+		return ttl, nil
+	}
+
+	lower := strings.ToLower(value)
+	total, start := 0, 0
+	for i := 0; i < len(lower); i++ {
+		if lower[i] >= '0' && lower[i] <= '9' {
+			continue
+		}
+		unit, ok := zoneTTLUnits[lower[i]]
+		if !ok || i == start {
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		}
+		n, err := strconv.Atoi(lower[start:i])
+		if err != nil {
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		}
+		total += n * unit
+		start = i + 1
+	}
+	if start != len(lower) {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+	return total, nil
+}
+
+type zoneLine struct {
+	number    int
+	text      string
+	continued bool
+}
+
+// zoneLines reads the logical lines of a zone file, stripping comments and joining parenthesized records.
+func zoneLines(r io.Reader) ([]zoneLine, error) {
+	var (
+		lines   []zoneLine
+		pending *zoneLine
+		depth   int
+	)
+
+	scanner := bufio.NewScanner(r)
+	number := 0
+	for scanner.Scan() {
+		number++
+		raw := scanner.Text()
+		text := stripZoneComment(raw)
+
+		if pending != nil {
+			pending.text += " " + text
+		} else {
+			if strings.TrimSpace(text) == "" {
+				continue
+			}
+			pending = &zoneLine{
+				number:    number,
+				text:      text,
+				continued: raw[0] == ' ' || raw[0] == '\t',
+			}
+		}
+
+		depth += strings.Count(text, "(") - strings.Count(text, ")")
+		if depth <= 0 {
+			pending.text = strings.NewReplacer("(", " ", ")", " ").Replace(pending.text)
+			lines = append(lines, *pending)
+			pending = nil
+			depth = 0
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read zone file: %w", err)
+	}
+	if pending != nil {
+		return nil, zoneError(pending.number, "unterminated parenthesis")
+	}
+	return lines, nil
+}
+
+// stripZoneComment removes a trailing comment, ignoring semicolons inside quoted strings.
+func stripZoneComment(line string) string {
+	inQuote := false
+	for i, r := range line {
+		switch r {
+		case '"':
+			inQuote = !inQuote
+		case ';':
+			if !inQuote {
+				return line[:i]
+			}
+		}
+	}
+	return line
+}
+
+// splitZoneFields splits a zone file line into fields, keeping quoted strings together without their quotes.
+func splitZoneFields(line string) []string {
+	var (
+		fields  []string
+		current strings.Builder
+		inQuote bool
+		quoted  bool
+	)
+	flush := func() {
+		if current.Len() > 0 || quoted {
+			fields = append(fields, current.String())
+		}
+		current.Reset()
+		quoted = false
+	}
+
+	for _, r := range line {
+		switch {
+		case r == '"':
+			inQuote = !inQuote
+			quoted = true
+		case !inQuote && (r == ' ' || r == '\t'):
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+	return fields
+}
+
+// qualifyZoneName converts a zone file name to a fully qualified domain without the trailing dot.
+func qualifyZoneName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, ".")
+	case origin == "":
+		return name
+	default:
+		return name + "." + origin
+	}
+}
+
+func zoneError(line int, message string) error {
+	return errors.NewValidationError("zoneFile", fmt.Sprintf("line %d: %s", line, message))
+}
+
+// DNSSyncPlan contains the changes needed to make the DNS policies of a site match a set of records.
+type DNSSyncPlan struct {
+	Create []DNSPolicy
+	// Update contains the desired records with the ID of the existing policy they replace.
+	Update []DNSPolicy
+	Delete []DNSPolicy
+}
+
+// PlanDNSSync computes the changes needed to turn existing into desired.
+// A desired record with TTLSeconds 0 keeps the TTL of the policy it matches.
+// Forwarded domains and system-defined policies are never deleted,
+// since they cannot be expressed in a zone file.
+func PlanDNSSync(existing, desired []DNSPolicy) DNSSyncPlan {
+	byKey := make(map[string][]DNSPolicy)
+	for _, p := range existing {
+		byKey[p.syncKey()] = append(byKey[p.syncKey()], p)
+	}
+
+	var plan DNSSyncPlan
+	matched := make(map[string]bool)
+	for _, want := range desired {
+		key := want.syncKey()
+		candidates := byKey[key]
+		if len(candidates) == 0 {
+			plan.Create = append(plan.Create, want)
+			continue
+		}
+
+		have := candidates[0]
+		byKey[key] = candidates[1:]
+		matched[have.ID] = true
+		if want.TTLSeconds == 0 {
+			// The zone file does not set a TTL, so the existing one is kept.
+			want.TTLSeconds = have.TTLSeconds
+		}
+		if !have.sameRecord(want) {
+			want.ID = have.ID
+			plan.Update = append(plan.Update, want)
+		}
+	}
+
+	for _, p := range existing {
+		if !matched[p.ID] && p.syncable() {
+			plan.Delete = append(plan.Delete, p)
+		}
+	}
+	return plan
+}
+
+// syncKey identifies the record a policy describes, so that changed settings become updates.
+func (p DNSPolicy) syncKey() string {
+	domain := strings.ToLower(p.Domain)
+	switch p.DNSPolicyType {
+	case DNSPolicyTypeA:
+		return fmt.Sprintf("%s|%s|%s", p.DNSPolicyType, domain, p.IPv4Address)
+	case DNSPolicyTypeAAAA:
+		return fmt.Sprintf("%s|%s|%s", p.DNSPolicyType, domain, strings.ToLower(p.IPv6Address))
+	case DNSPolicyTypeMX:
+		return fmt.Sprintf("%s|%s|%s", p.DNSPolicyType, domain, strings.ToLower(p.MailServerDomain))
+	case DNSPolicyTypeTXT:
+		return fmt.Sprintf("%s|%s|%s", p.DNSPolicyType, domain, p.Text)
+	case DNSPolicyTypeSRV:
+		return fmt.Sprintf("%s|%s.%s.%s|%s:%d", p.DNSPolicyType, p.Service, p.Protocol, domain, strings.ToLower(p.ServerDomain), p.Port)
+	default:
+		return fmt.Sprintf("%s|%s", p.DNSPolicyType, domain)
+	}
+}
+
+// sameRecord reports whether two policies with the same sync key have identical settings.
+func (p DNSPolicy) sameRecord(o DNSPolicy) bool {
+	return p.Enabled == o.Enabled &&
+		p.TTLSeconds == o.TTLSeconds &&
+		strings.EqualFold(p.TargetDomain, o.TargetDomain) &&
+		p.Priority == o.Priority &&
+		p.Weight == o.Weight
+}
+
+// syncable reports whether a policy is managed by a zone file synchronization.
+func (p DNSPolicy) syncable() bool {
+	if p.Metadata != nil && p.Metadata.Origin == NetworkMetadataOriginSystemDefined {
+		return false
+	}
+	return p.DNSPolicyType != DNSPolicyTypeForwardDomain
+}
+
+// DNSSyncOptions configures SyncDNSPolicies.
+type DNSSyncOptions struct {
+	// DryRun computes the plan without changing any policy.
+	DryRun bool
+}
+
+// SyncDNSPolicies makes the DNS policies of a site match the desired records, typically parsed
+// with ParseZoneFile, and returns the applied plan. Changes are applied in order
+// (deletes, updates, creates) and the first failure stops the synchronization.
+func (n *Network) SyncDNSPolicies(ctx context.Context, siteID string, desired []DNSPolicy, opts *DNSSyncOptions) (*DNSSyncPlan, error) {
+	if strings.TrimSpace(siteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	existing, err := listAll(func(offset int) ([]DNSPolicy, int, error) {
+		resp, err := n.ListDNSPolicies(ctx, &ListDNSPoliciesRequest{SiteID: siteID, Offset: offset, Limit: pageLimit})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Data, resp.TotalCount, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	plan := PlanDNSSync(existing, desired)
+	if opts != nil && opts.DryRun {
+		return &plan, nil
+	}
+
+	for _, p := range plan.Delete {
+		if err := n.DeleteDNSPolicy(ctx, &DeleteDNSPolicyRequest{SiteID: siteID, DNSPolicyID: p.ID}); err != nil {
+			return &plan, fmt.Errorf("failed to delete DNS policy %s: %w", p.Domain, err)
+		}
+	}
+	for _, p := range plan.Update {
+		if _, err := n.UpdateDNSPolicy(ctx, &UpdateDNSPolicyRequest{SiteID: siteID, DNSPolicyID: p.ID, DNSPolicy: p}); err != nil {
+			return &plan, fmt.Errorf("failed to update DNS policy %s: %w", p.Domain, err)
+		}
+	}
+	for _, p := range plan.Create {
+		if _, err := n.CreateDNSPolicy(ctx, &CreateDNSPolicyRequest{SiteID: siteID, DNSPolicy: p}); err != nil {
+			return &plan, fmt.Errorf("failed to create DNS policy %s: %w", p.Domain, err)
+		}
+	}
+
+	return &plan, nil
+}
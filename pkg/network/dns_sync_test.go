@@ -0,0 +1,154 @@
+package network
+
+import (
+	"strings"
+	"testing"
+
+	pkgerrors "github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+const testZoneFile = `$ORIGIN lab.example.com.
+$TTL 3600
+@       IN SOA ns1 hostmaster (
+            2024010101 ; serial
+            3600 900 604800 300 )
+        IN NS  ns1
+nas         A     10.0.0.10
+            AAAA  fd00::10
+www   300   IN CNAME nas
+@           MX    10 mail.example.org.
+@           TXT   "v=spf1 mx; -all"
+_ldap._tcp  SRV   0 5 389 dc1
+`
+
+// TestParseZoneFile tests parsing of the supported record types.
+func TestParseZoneFile(t *testing.T) {
+	t.Parallel()
+
+	policies, err := ParseZoneFile(strings.NewReader(testZoneFile), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []DNSPolicy{
+		{DNSPolicyType: DNSPolicyTypeA, Enabled: true, Domain: "nas.lab.example.com", TTLSeconds: 3600, IPv4Address: "10.0.0.10"},
+		{DNSPolicyType: DNSPolicyTypeAAAA, Enabled: true, Domain: "nas.lab.example.com", TTLSeconds: 3600, IPv6Address: "fd00::10"},
+		{DNSPolicyType: DNSPolicyTypeCNAME, Enabled: true, Domain: "www.lab.example.com", TTLSeconds: 300, TargetDomain: "nas.lab.example.com"},
+		{DNSPolicyType: DNSPolicyTypeMX, Enabled: true, Domain: "lab.example.com", TTLSeconds: 3600, MailServerDomain: "mail.example.org", Priority: 10},
+		{DNSPolicyType: DNSPolicyTypeTXT, Enabled: true, Domain: "lab.example.com", TTLSeconds: 3600, Text: "v=spf1 mx; -all"},
+		{DNSPolicyType: DNSPolicyTypeSRV, Enabled: true, Domain: "lab.example.com", TTLSeconds: 3600, Service: "_ldap", Protocol: "_tcp", Priority: 0, Weight: 5, Port: 389, ServerDomain: "dc1.lab.example.com"},
+	}
+
+	if len(policies) != len(want) {
+		t.Fatalf("len(policies) = %d, want %d: %+v", len(policies), len(want), policies)
+	}
+	for i := range want {
+		if policies[i] != want[i] {
+			t.Errorf("policies[%d] = %+v, want %+v", i, policies[i], want[i])
+		}
+	}
+}
+
+// TestParseZoneFileErrors tests that malformed zone files are rejected.
+func TestParseZoneFileErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"unsupported type":   "host IN HINFO cpu os\n",
+		"missing data":       "host IN MX 10\n",
+		"invalid priority":   "host IN MX high mail\n",
+		"unterminated paren": "@ IN SOA ns1 hostmaster ( 1 2 3\n",
+		"invalid $TTL unit":  "$TTL 1y\nhost IN A 10.0.0.1\n",
+	}
+	for name, zone := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := ParseZoneFile(strings.NewReader(zone), "example.com"); !pkgerrors.IsValidationError(err) {
+				t.Errorf("error = %v, want validation error", err)
+			}
+		})
+	}
+}
+
+// TestParseZoneFileTTLUnits tests TTLs given with BIND unit suffixes.
+func TestParseZoneFileTTLUnits(t *testing.T) {
+	t.Parallel()
+
+	zone := "$TTL 1h\na A 10.0.0.1\nb 1h30m IN A 10.0.0.2\nc 1W2d A 10.0.0.3\nd 90s A 10.0.0.4\n"
+	policies, err := ParseZoneFile(strings.NewReader(zone), "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]int{
+		"a.example.com": 3600,
+		"b.example.com": 5400,
+		"c.example.com": 777600,
+		"d.example.com": 90,
+	}
+	if len(policies) != len(want) {
+		t.Fatalf("len(policies) = %d, want %d: %+v", len(policies), len(want), policies)
+	}
+	for _, policy := range policies {
+		if policy.TTLSeconds != want[policy.Domain] {
+			t.Errorf("%s TTLSeconds = %d, want %d", policy.Domain, policy.TTLSeconds, want[policy.Domain])
+		}
+	}
+}
+
+// TestPlanDNSSync tests the creates, updates and deletes computed for a sync.
+func TestPlanDNSSync(t *testing.T) {
+	t.Parallel()
+
+	existing := []DNSPolicy{
+		{ID: "1", DNSPolicyType: DNSPolicyTypeA, Enabled: true, Domain: "nas.lab", IPv4Address: "10.0.0.10", TTLSeconds: 3600},
+		{ID: "2", DNSPolicyType: DNSPolicyTypeCNAME, Enabled: true, Domain: "www.lab", TargetDomain: "old.lab"},
+		{ID: "3", DNSPolicyType: DNSPolicyTypeA, Enabled: true, Domain: "stale.lab", IPv4Address: "10.0.0.99"},
+		{ID: "4", DNSPolicyType: DNSPolicyTypeForwardDomain, Enabled: true, Domain: "corp", IPAddress: "10.1.1.1"},
+		{ID: "5", DNSPolicyType: DNSPolicyTypeA, Enabled: true, Domain: "gw.lab", IPv4Address: "10.0.0.1", Metadata: &NetworkDetailMetadata{Origin: NetworkMetadataOriginSystemDefined}},
+	}
+	desired := []DNSPolicy{
+		{DNSPolicyType: DNSPolicyTypeA, Enabled: true, Domain: "NAS.lab", IPv4Address: "10.0.0.10", TTLSeconds: 3600},
+		{DNSPolicyType: DNSPolicyTypeCNAME, Enabled: true, Domain: "www.lab", TargetDomain: "nas.lab"},
+		{DNSPolicyType: DNSPolicyTypeA, Enabled: true, Domain: "printer.lab", IPv4Address: "10.0.0.20"},
+	}
+
+	plan := PlanDNSSync(existing, desired)
+
+	if len(plan.Create) != 1 || plan.Create[0].Domain != "printer.lab" {
+		t.Errorf("Create = %+v, want printer.lab", plan.Create)
+	}
+	if len(plan.Update) != 1 || plan.Update[0].ID != "2" || plan.Update[0].TargetDomain != "nas.lab" {
+		t.Errorf("Update = %+v, want www.lab with ID 2 pointing to nas.lab", plan.Update)
+	}
+	if len(plan.Delete) != 1 || plan.Delete[0].ID != "3" {
+		t.Errorf("Delete = %+v, want stale.lab", plan.Delete)
+	}
+}
+
+// TestPlanDNSSyncKeepsExistingTTL tests that records without a TTL keep the TTL of the existing policy.
+func TestPlanDNSSyncKeepsExistingTTL(t *testing.T) {
+	t.Parallel()
+
+	existing := []DNSPolicy{
+		{ID: "1", DNSPolicyType: DNSPolicyTypeA, Enabled: true, Domain: "nas.lab", IPv4Address: "10.0.0.10", TTLSeconds: 300},
+		{ID: "2", DNSPolicyType: DNSPolicyTypeCNAME, Enabled: true, Domain: "www.lab", TargetDomain: "old.lab", TTLSeconds: 300},
+	}
+	desired, err := ParseZoneFile(strings.NewReader("nas A 10.0.0.10\nwww CNAME nas\n"), "lab")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	plan := PlanDNSSync(existing, desired)
+
+	if len(plan.Create) != 0 || len(plan.Delete) != 0 {
+		t.Errorf("plan = %+v, want only an update", plan)
+	}
+	if len(plan.Update) != 1 {
+		t.Fatalf("Update = %+v, want www.lab", plan.Update)
+	}
+	if got := plan.Update[0]; got.ID != "2" || got.TTLSeconds != 300 || got.TargetDomain != "nas.lab" {
+		t.Errorf("Update[0] = %+v, want ID 2 with TTLSeconds 300 pointing to nas.lab", got)
+	}
+}
@@ -0,0 +1,202 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+const (
+	// DefaultEventBufferSize is the default capacity of the channel returned by SubscribeEvents.
+	DefaultEventBufferSize = 64
+	// DefaultEventMinBackoff is the default delay before the first reconnect attempt.
+	DefaultEventMinBackoff = time.Second
+	// DefaultEventMaxBackoff is the default upper bound of the reconnect delay.
+	DefaultEventMaxBackoff = time.Minute
+)
+
+// SubscribeEventsOptions configures an event subscription.
+type SubscribeEventsOptions struct {
+	// Site is the site short name (default: Config.Site).
+	Site string
+	// BufferSize is the capacity of the returned channel (default: DefaultEventBufferSize).
+	BufferSize int
+	// MinBackoff and MaxBackoff bound the reconnect delay, which doubles after each failed attempt.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// OnError is called with the error that dropped the connection and with each failed reconnect (optional).
+	OnError func(error)
+}
+
+// GET /wss/s/{site}/events
+
+// SubscribeEvents connects to the controller event stream and delivers events on the returned channel
+// until ctx is cancelled, after which the channel is closed. The first connection is made before returning,
+// so authentication errors are reported directly; later disconnects are retried with exponential backoff.
+func (n *Network) SubscribeEvents(ctx context.Context, opts *SubscribeEventsOptions) (<-chan Event, error) {
+	var o SubscribeEventsOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Site == "" {
+		o.Site = n.site
+	}
+	if strings.TrimSpace(o.Site) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if o.BufferSize <= 0 {
+		o.BufferSize = DefaultEventBufferSize
+	}
+	if o.MinBackoff <= 0 {
+		o.MinBackoff = DefaultEventMinBackoff
+	}
+	if o.MaxBackoff <= 0 {
+		o.MaxBackoff = DefaultEventMaxBackoff
+	}
+	if o.MaxBackoff < o.MinBackoff {
+		o.MaxBackoff = o.MinBackoff
+	}
+
+	if err := n.resolvePlatform(ctx); err != nil {
+		return nil, err
+	}
+	path := n.legacyPath(fmt.Sprintf("/wss/s/%s/events", o.Site))
+
+	conn, err := n.dialEvents(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan Event, o.BufferSize)
+	go n.streamEvents(ctx, path, conn, events, o)
+	return events, nil
+}
+
+// dialEvents connects to the event stream, renewing an expired session once.
+func (n *Network) dialEvents(ctx context.Context, path string) (*wsConn, error) {
+	session := n.session.Load()
+
+	conn, err := n.dialWebSocket(ctx, path)
+	if !errors.IsAuthenticationError(err) || !n.canReauthenticate() {
+		return conn, err
+	}
+
+	if err := n.reauthenticate(ctx, session); err != nil {
+		return nil, err
+	}
+	return n.dialWebSocket(ctx, path)
+}
+
+// streamEvents reads events until ctx is cancelled, reconnecting whenever the connection drops.
+func (n *Network) streamEvents(ctx context.Context, path string, conn *wsConn, events chan<- Event, o SubscribeEventsOptions) {
+	defer close(events)
+
+	report := func(err error) {
+		if o.OnError != nil && ctx.Err() == nil {
+			o.OnError(err)
+		}
+	}
+
+	backoff := o.MinBackoff
+	for {
+		received, err := readEvents(ctx, conn, events)
+		conn.Close()
+		if ctx.Err() != nil {
+			return
+		}
+		report(fmt.Errorf("event stream disconnected: %w", err))
+
+		// Only a connection that delivered messages resets the backoff, so a controller that
+		// accepts and immediately drops connections is not hammered.
+		if received {
+			backoff = o.MinBackoff
+		}
+
+		for {
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+			backoff = min(backoff*2, o.MaxBackoff)
+
+			conn, err = n.dialEvents(ctx, path)
+			if err == nil {
+				break
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			report(fmt.Errorf("event stream reconnect failed: %w", err))
+		}
+	}
+}
+
+// readEvents delivers the events of a connection until it fails or ctx is cancelled.
+// It reports whether at least one message was received.
+func readEvents(ctx context.Context, conn *wsConn, events chan<- Event) (bool, error) {
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	received := false
+	for {
+		message, err := conn.ReadMessage()
+		if err != nil {
+			return received, err
+		}
+		received = true
+
+		for _, event := range decodeEvents(message) {
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return received, ctx.Err()
+			}
+		}
+	}
+}
+
+// decodeEvents splits a websocket message into events. Messages that are not a
+// {"meta":{"message":...},"data":[...]} envelope are returned as a single untyped event.
+func decodeEvents(message []byte) []Event {
+	var envelope struct {
+		Meta struct {
+			Message EventType `json:"message"`
+		} `json:"meta"`
+		Data []json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(message, &envelope); err != nil || envelope.Meta.Message == "" {
+		return []Event{{Raw: json.RawMessage(message)}}
+	}
+
+	events := make([]Event, 0, len(envelope.Data))
+	for _, raw := range envelope.Data {
+		event := Event{Type: envelope.Meta.Message, Raw: raw}
+
+		switch event.Type {
+		case EventTypeEvents:
+			var controller ControllerEvent
+			if json.Unmarshal(raw, &controller) == nil {
+				event.Controller = &controller
+			}
+		case EventTypeClientSync:
+			var client LegacyClient
+			if json.Unmarshal(raw, &client) == nil {
+				event.Client = &client
+			}
+		case EventTypeDeviceSync, EventTypeDeviceUpdate:
+			var device LegacyDevice
+			if json.Unmarshal(raw, &device) == nil {
+				event.Device = &device
+			}
+		}
+		events = append(events, event)
+	}
+	return events
+}
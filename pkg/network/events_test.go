@@ -0,0 +1,108 @@
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestNetwork_SubscribeEvents tests decoding events and reconnecting after the controller drops the stream.
+func TestNetwork_SubscribeEvents(t *testing.T) {
+	t.Parallel()
+
+	messages := []string{
+		`{"meta":{"rc":"ok","message":"events"},"data":[{"key":"EVT_WU_Connected","user":"aa:bb:cc:dd:ee:ff","ssid":"Home","time":1700000000000}]}`,
+		`{"meta":{"rc":"ok","message":"device:sync"},"data":[{"mac":"11:22:33:44:55:66","ip":"10.0.0.2","state":0}]}`,
+	}
+
+	var connections atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			http.Redirect(w, r, "/manage", http.StatusFound)
+			return
+		case "/wss/s/default/events":
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		if r.Header.Get("X-API-KEY") != "test-key" {
+			t.Errorf("missing API key on handshake")
+		}
+
+		n := connections.Add(1)
+		if int(n) > len(messages) {
+			// Keep later connections open until the client goes away.
+			<-r.Context().Done()
+			return
+		}
+
+		conn, rw, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			t.Errorf("hijack failed: %v", err)
+			return
+		}
+		defer conn.Close()
+
+		rw.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n")
+		rw.WriteString("Sec-WebSocket-Accept: " + websocketAccept(r.Header.Get("Sec-WebSocket-Key")) + "\r\n\r\n")
+
+		// Send one message per connection, then drop it to force a reconnect.
+		payload := []byte(messages[n-1])
+		frame := []byte{0x81, 126}
+		frame = binary.BigEndian.AppendUint16(frame, uint16(len(payload)))
+		rw.Write(append(frame, payload...))
+		rw.Flush()
+	}))
+	defer server.Close()
+
+	client := newTestNetwork(t, server, Config{APIKey: "test-key"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events, err := client.SubscribeEvents(ctx, &SubscribeEventsOptions{MinBackoff: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("SubscribeEvents() error = %v", err)
+	}
+
+	first := <-events
+	if first.Type != EventTypeEvents || first.Controller == nil {
+		t.Fatalf("first event = %+v", first)
+	}
+	if first.Controller.Key != EventKeyWirelessClientConnected || first.Controller.User != "aa:bb:cc:dd:ee:ff" {
+		t.Errorf("controller event = %+v", first.Controller)
+	}
+
+	second := <-events
+	if second.Type != EventTypeDeviceSync || second.Device == nil || second.Device.IP != "10.0.0.2" {
+		t.Fatalf("second event = %+v", second)
+	}
+
+	cancel()
+	for range events {
+	}
+	if connections.Load() < 2 {
+		t.Errorf("connections = %d, want at least 2", connections.Load())
+	}
+}
+
+// TestDecodeEvents tests the raw fallback for messages without an envelope.
+func TestDecodeEvents(t *testing.T) {
+	t.Parallel()
+
+	events := decodeEvents([]byte(`{"hello":"world"}`))
+	if len(events) != 1 || events[0].Type != "" || string(events[0].Raw) != `{"hello":"world"}` {
+		t.Errorf("decodeEvents() = %+v", events)
+	}
+
+	events = decodeEvents([]byte(`{"meta":{"message":"speed-test:update"},"data":[{"a":1},{"a":2}]}`))
+	if len(events) != 2 || events[1].Type != "speed-test:update" || string(events[1].Raw) != `{"a":2}` {
+		t.Errorf("decodeEvents() = %+v", events)
+	}
+}
@@ -0,0 +1,258 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+type ListFirewallPoliciesRequest struct {
+	SiteID string `json:"siteId"`
+
+	Offset int    `json:"offset"`
+	Limit  int    `json:"limit"`
+	Filter string `json:"filter"`
+}
+
+// ToQuery converts the request to URL query string.
+func (r *ListFirewallPoliciesRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
+type ListFirewallPoliciesResponse struct {
+	Offset     int              `json:"offset"`
+	Limit      int              `json:"limit"`
+	Count      int              `json:"count"`
+	TotalCount int              `json:"totalCount"`
+	Data       []FirewallPolicy `json:"data"`
+}
+
+// GET /v1/sites/{siteId}/firewall/policies
+
+// ListFirewallPolicies retrieves the firewall policies of a site.
+func (n *Network) ListFirewallPolicies(ctx context.Context, req *ListFirewallPoliciesRequest) (*ListFirewallPoliciesResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/firewall/policies", req.SiteID) + req.ToQuery()
+
+	var resp ListFirewallPoliciesResponse
+	if err := n.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type FirewallPolicyDetailsRequest struct {
+	PolicyID string `json:"policyId"`
+	SiteID   string `json:"siteId"`
+}
+
+type FirewallPolicyDetailsResponse struct {
+	FirewallPolicy
+}
+
+// GET /v1/sites/{siteId}/firewall/policies/{policyId}
+
+// GetFirewallPolicy retrieves a single firewall policy.
+func (n *Network) GetFirewallPolicy(ctx context.Context, req *FirewallPolicyDetailsRequest) (*FirewallPolicyDetailsResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.PolicyID) == "" {
+		return nil, errors.ErrEmptyPolicyID
+	}
+
+	var resp FirewallPolicyDetailsResponse
+	if err := n.get(ctx, fmt.Sprintf("/v1/sites/%s/firewall/policies/%s", req.SiteID, req.PolicyID), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type CreateFirewallPolicyRequest struct {
+	SiteID string `json:"-"`
+
+	Name                  string                    `json:"name"`
+	Description           string                    `json:"description,omitempty"`
+	Enabled               bool                      `json:"enabled"`
+	Action                FirewallPolicyAction      `json:"action"`
+	Source                FirewallPolicyEndpoint    `json:"source"`
+	Destination           FirewallPolicyEndpoint    `json:"destination"`
+	IPProtocolScope       FirewallIPProtocolScope   `json:"ipProtocolScope"`
+	ConnectionStateFilter []FirewallConnectionState `json:"connectionStateFilter,omitempty"`
+	LoggingEnabled        bool                      `json:"loggingEnabled"`
+}
+
+type CreateFirewallPolicyResponse struct {
+	FirewallPolicy
+}
+
+// POST /v1/sites/{siteId}/firewall/policies
+
+// CreateFirewallPolicy creates a new firewall policy.
+func (n *Network) CreateFirewallPolicy(ctx context.Context, req *CreateFirewallPolicyRequest) (*CreateFirewallPolicyResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if err := validateFirewallPolicyEndpoints(req.Source, req.Destination); err != nil {
+		return nil, err
+	}
+
+	var resp CreateFirewallPolicyResponse
+	if err := n.post(ctx, fmt.Sprintf("/v1/sites/%s/firewall/policies", req.SiteID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type UpdateFirewallPolicyRequest struct {
+	PolicyID string `json:"-"`
+	SiteID   string `json:"-"`
+
+	Name                  string                    `json:"name"`
+	Description           string                    `json:"description,omitempty"`
+	Enabled               bool                      `json:"enabled"`
+	Action                FirewallPolicyAction      `json:"action"`
+	Source                FirewallPolicyEndpoint    `json:"source"`
+	Destination           FirewallPolicyEndpoint    `json:"destination"`
+	IPProtocolScope       FirewallIPProtocolScope   `json:"ipProtocolScope"`
+	ConnectionStateFilter []FirewallConnectionState `json:"connectionStateFilter,omitempty"`
+	LoggingEnabled        bool                      `json:"loggingEnabled"`
+}
+
+type UpdateFirewallPolicyResponse struct {
+	FirewallPolicy
+}
+
+// PUT /v1/sites/{siteId}/firewall/policies/{policyId}
+
+// UpdateFirewallPolicy replaces the configuration of a firewall policy.
+func (n *Network) UpdateFirewallPolicy(ctx context.Context, req *UpdateFirewallPolicyRequest) (*UpdateFirewallPolicyResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.PolicyID) == "" {
+		return nil, errors.ErrEmptyPolicyID
+	}
+	if err := validateFirewallPolicyEndpoints(req.Source, req.Destination); err != nil {
+		return nil, err
+	}
+
+	var resp UpdateFirewallPolicyResponse
+	if err := n.put(ctx, fmt.Sprintf("/v1/sites/%s/firewall/policies/%s", req.SiteID, req.PolicyID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type DeleteFirewallPolicyRequest struct {
+	PolicyID string `json:"policyId"`
+	SiteID   string `json:"siteId"`
+}
+
+// DELETE /v1/sites/{siteId}/firewall/policies/{policyId}
+
+// DeleteFirewallPolicy deletes a firewall policy.
+func (n *Network) DeleteFirewallPolicy(ctx context.Context, req *DeleteFirewallPolicyRequest) error {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.PolicyID) == "" {
+		return errors.ErrEmptyPolicyID
+	}
+
+	return n.delete(ctx, fmt.Sprintf("/v1/sites/%s/firewall/policies/%s", req.SiteID, req.PolicyID), nil)
+}
+
+type FirewallPolicyOrderingRequest struct {
+	SiteID            string `json:"siteId"`
+	SourceZoneID      string `json:"sourceZoneId"`
+	DestinationZoneID string `json:"destinationZoneId"`
+}
+
+// ToQuery converts the request to URL query string.
+func (r *FirewallPolicyOrderingRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	params := url.Values{}
+	params.Set("sourceFirewallZoneId", r.SourceZoneID)
+	params.Set("destinationFirewallZoneId", r.DestinationZoneID)
+	return "?" + params.Encode()
+}
+
+type FirewallPolicyOrderingResponse struct {
+	FirewallPolicyOrdering
+}
+
+// GET /v1/sites/{siteId}/firewall/policies/ordering
+
+// GetFirewallPolicyOrdering retrieves the evaluation order of the policies between two zones.
+func (n *Network) GetFirewallPolicyOrdering(ctx context.Context, req *FirewallPolicyOrderingRequest) (*FirewallPolicyOrderingResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.SourceZoneID) == "" || strings.TrimSpace(req.DestinationZoneID) == "" {
+		return nil, errors.ErrEmptyZoneID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/firewall/policies/ordering", req.SiteID) + req.ToQuery()
+
+	var resp FirewallPolicyOrderingResponse
+	if err := n.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type UpdateFirewallPolicyOrderingRequest struct {
+	SiteID            string `json:"-"`
+	SourceZoneID      string `json:"-"`
+	DestinationZoneID string `json:"-"`
+
+	FirewallPolicyOrdering
+}
+
+type UpdateFirewallPolicyOrderingResponse struct {
+	FirewallPolicyOrdering
+}
+
+// PUT /v1/sites/{siteId}/firewall/policies/ordering
+
+// UpdateFirewallPolicyOrdering reorders the user-defined policies between two zones.
+func (n *Network) UpdateFirewallPolicyOrdering(ctx context.Context, req *UpdateFirewallPolicyOrderingRequest) (*UpdateFirewallPolicyOrderingResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.SourceZoneID) == "" || strings.TrimSpace(req.DestinationZoneID) == "" {
+		return nil, errors.ErrEmptyZoneID
+	}
+
+	query := &FirewallPolicyOrderingRequest{SourceZoneID: req.SourceZoneID, DestinationZoneID: req.DestinationZoneID}
+	path := fmt.Sprintf("/v1/sites/%s/firewall/policies/ordering", req.SiteID) + query.ToQuery()
+
+	var resp UpdateFirewallPolicyOrderingResponse
+	if err := n.put(ctx, path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// validateFirewallPolicyEndpoints checks that both policy endpoints reference a zone.
+func validateFirewallPolicyEndpoints(source, destination FirewallPolicyEndpoint) error {
+	if strings.TrimSpace(source.ZoneID) == "" {
+		return errors.NewValidationError("source.zoneId", "cannot be empty")
+	}
+	if strings.TrimSpace(destination.ZoneID) == "" {
+		return errors.NewValidationError("destination.zoneId", "cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	pkgerrors "github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// TestNetwork_AssignNetworksToZone tests that networks are merged into a zone without duplicates.
+func TestNetwork_AssignNetworksToZone(t *testing.T) {
+	t.Parallel()
+
+	const zonePath = "/proxy/network/integration/v1/sites/site-1/firewall/zones/zone-1"
+
+	var update map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /":
+			w.WriteHeader(http.StatusOK)
+		case "GET " + zonePath:
+			json.NewEncoder(w).Encode(FirewallZone{ID: "zone-1", Name: "IoT", NetworkIDs: []string{"net-1", "net-2"}})
+		case "PUT " + zonePath:
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				t.Errorf("failed to decode body: %v", err)
+			}
+			json.NewEncoder(w).Encode(FirewallZone{ID: "zone-1", Name: "IoT", NetworkIDs: []string{"net-1", "net-2", "net-3"}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	n := newTestNetwork(t, server, Config{APIKey: "key"})
+
+	zone, err := n.AssignNetworksToZone(context.Background(), "site-1", "zone-1", "net-2", "net-3", "net-3")
+	if err != nil {
+		t.Fatalf("AssignNetworksToZone() error = %v", err)
+	}
+	if len(zone.NetworkIDs) != 3 {
+		t.Errorf("NetworkIDs = %v", zone.NetworkIDs)
+	}
+
+	want := map[string]interface{}{
+		"name":       "IoT",
+		"networkIds": []interface{}{"net-1", "net-2", "net-3"},
+	}
+	if !reflect.DeepEqual(update, want) {
+		t.Errorf("PUT body = %v, want %v", update, want)
+	}
+}
+
+// TestValidateFirewallPolicyEndpoints tests that both policy endpoints must reference a zone.
+func TestValidateFirewallPolicyEndpoints(t *testing.T) {
+	t.Parallel()
+
+	zone := FirewallPolicyEndpoint{ZoneID: "zone-1"}
+
+	tests := []struct {
+		name        string
+		source      FirewallPolicyEndpoint
+		destination FirewallPolicyEndpoint
+		wantField   string
+	}{
+		{name: "valid", source: zone, destination: zone},
+		{name: "missing source zone", destination: zone, wantField: "source.zoneId"},
+		{name: "blank destination zone", source: zone, destination: FirewallPolicyEndpoint{ZoneID: " "}, wantField: "destination.zoneId"},
+		{
+			name:        "traffic filter without zone",
+			source:      FirewallPolicyEndpoint{TrafficFilter: &FirewallTrafficFilter{}},
+			destination: zone,
+			wantField:   "source.zoneId",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateFirewallPolicyEndpoints(tt.source, tt.destination)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var validationErr *pkgerrors.ValidationError
+			if !errors.As(err, &validationErr) || validationErr.Field != tt.wantField {
+				t.Errorf("error = %v, want validation error on %s", err, tt.wantField)
+			}
+		})
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	n := newTestNetwork(t, server, Config{APIKey: "key"})
+	ctx := context.Background()
+
+	if _, err := n.CreateFirewallPolicy(ctx, &CreateFirewallPolicyRequest{SiteID: "site-1", Source: zone}); !pkgerrors.IsValidationError(err) {
+		t.Errorf("CreateFirewallPolicy() error = %v, want validation error", err)
+	}
+	if _, err := n.UpdateFirewallPolicy(ctx, &UpdateFirewallPolicyRequest{SiteID: "site-1", PolicyID: "policy-1", Destination: zone}); !pkgerrors.IsValidationError(err) {
+		t.Errorf("UpdateFirewallPolicy() error = %v, want validation error", err)
+	}
+}
+
+// TestNetwork_FirewallPolicyOrdering tests the zone pair query of the ordering endpoints.
+func TestNetwork_FirewallPolicyOrdering(t *testing.T) {
+	t.Parallel()
+
+	ordering := FirewallPolicyOrdering{OrderedFirewallPolicyIDs: FirewallOrderedPolicyIDs{
+		BeforeSystemDefined: []string{"policy-2", "policy-1"},
+		AfterSystemDefined:  []string{"policy-3"},
+	}}
+
+	var methods []string
+	var update FirewallPolicyOrdering
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			w.WriteHeader(http.StatusOK)
+		case "/proxy/network/integration/v1/sites/site-1/firewall/policies/ordering":
+			methods = append(methods, r.Method)
+			query := r.URL.Query()
+			if query.Get("sourceFirewallZoneId") != "zone-a" || query.Get("destinationFirewallZoneId") != "zone-b" {
+				t.Errorf("%s query = %q", r.Method, r.URL.RawQuery)
+			}
+			if r.Method == http.MethodPut {
+				var body map[string]json.RawMessage
+				json.NewDecoder(r.Body).Decode(&body)
+				if len(body) != 1 {
+					t.Errorf("PUT body keys = %v, want only orderedFirewallPolicyIds", body)
+				}
+				json.Unmarshal(body["orderedFirewallPolicyIds"], &update.OrderedFirewallPolicyIDs)
+			}
+			json.NewEncoder(w).Encode(ordering)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	n := newTestNetwork(t, server, Config{APIKey: "key"})
+	ctx := context.Background()
+
+	got, err := n.GetFirewallPolicyOrdering(ctx, &FirewallPolicyOrderingRequest{SiteID: "site-1", SourceZoneID: "zone-a", DestinationZoneID: "zone-b"})
+	if err != nil {
+		t.Fatalf("GetFirewallPolicyOrdering() error = %v", err)
+	}
+	if !reflect.DeepEqual(got.FirewallPolicyOrdering, ordering) {
+		t.Errorf("ordering = %+v, want %+v", got.FirewallPolicyOrdering, ordering)
+	}
+
+	if _, err := n.UpdateFirewallPolicyOrdering(ctx, &UpdateFirewallPolicyOrderingRequest{
+		SiteID:                 "site-1",
+		SourceZoneID:           "zone-a",
+		DestinationZoneID:      "zone-b",
+		FirewallPolicyOrdering: ordering,
+	}); err != nil {
+		t.Fatalf("UpdateFirewallPolicyOrdering() error = %v", err)
+	}
+	if !reflect.DeepEqual(update, ordering) {
+		t.Errorf("PUT ordering = %+v, want %+v", update, ordering)
+	}
+	if !reflect.DeepEqual(methods, []string{http.MethodGet, http.MethodPut}) {
+		t.Errorf("methods = %v", methods)
+	}
+
+	if _, err := n.GetFirewallPolicyOrdering(ctx, &FirewallPolicyOrderingRequest{SiteID: "site-1", SourceZoneID: "zone-a"}); !errors.Is(err, pkgerrors.ErrEmptyZoneID) {
+		t.Errorf("GetFirewallPolicyOrdering() error = %v, want ErrEmptyZoneID", err)
+	}
+}
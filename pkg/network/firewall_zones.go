@@ -0,0 +1,186 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+type ListFirewallZonesRequest struct {
+	SiteID string `json:"siteId"`
+
+	Offset int    `json:"offset"`
+	Limit  int    `json:"limit"`
+	Filter string `json:"filter"`
+}
+
+// ToQuery converts the request to URL query string.
+func (r *ListFirewallZonesRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
+type ListFirewallZonesResponse struct {
+	Offset     int            `json:"offset"`
+	Limit      int            `json:"limit"`
+	Count      int            `json:"count"`
+	TotalCount int            `json:"totalCount"`
+	Data       []FirewallZone `json:"data"`
+}
+
+// GET /v1/sites/{siteId}/firewall/zones
+
+// ListFirewallZones retrieves the firewall zones of a site.
+func (n *Network) ListFirewallZones(ctx context.Context, req *ListFirewallZonesRequest) (*ListFirewallZonesResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/firewall/zones", req.SiteID) + req.ToQuery()
+
+	var resp ListFirewallZonesResponse
+	if err := n.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type FirewallZoneDetailsRequest struct {
+	ZoneID string `json:"zoneId"`
+	SiteID string `json:"siteId"`
+}
+
+type FirewallZoneDetailsResponse struct {
+	FirewallZone
+}
+
+// GET /v1/sites/{siteId}/firewall/zones/{zoneId}
+
+// GetFirewallZone retrieves a single firewall zone.
+func (n *Network) GetFirewallZone(ctx context.Context, req *FirewallZoneDetailsRequest) (*FirewallZoneDetailsResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.ZoneID) == "" {
+		return nil, errors.ErrEmptyZoneID
+	}
+
+	var resp FirewallZoneDetailsResponse
+	if err := n.get(ctx, fmt.Sprintf("/v1/sites/%s/firewall/zones/%s", req.SiteID, req.ZoneID), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type CreateFirewallZoneRequest struct {
+	SiteID string `json:"-"`
+
+	Name       string   `json:"name"`
+	NetworkIDs []string `json:"networkIds"`
+}
+
+type CreateFirewallZoneResponse struct {
+	FirewallZone
+}
+
+// POST /v1/sites/{siteId}/firewall/zones
+
+// CreateFirewallZone creates a new firewall zone.
+func (n *Network) CreateFirewallZone(ctx context.Context, req *CreateFirewallZoneRequest) (*CreateFirewallZoneResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, errors.NewValidationError("name", "cannot be empty")
+	}
+
+	var resp CreateFirewallZoneResponse
+	if err := n.post(ctx, fmt.Sprintf("/v1/sites/%s/firewall/zones", req.SiteID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type UpdateFirewallZoneRequest struct {
+	ZoneID string `json:"-"`
+	SiteID string `json:"-"`
+
+	Name       string   `json:"name"`
+	NetworkIDs []string `json:"networkIds"`
+}
+
+type UpdateFirewallZoneResponse struct {
+	FirewallZone
+}
+
+// PUT /v1/sites/{siteId}/firewall/zones/{zoneId}
+
+// UpdateFirewallZone replaces the name and networks of a firewall zone.
+func (n *Network) UpdateFirewallZone(ctx context.Context, req *UpdateFirewallZoneRequest) (*UpdateFirewallZoneResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.ZoneID) == "" {
+		return nil, errors.ErrEmptyZoneID
+	}
+
+	var resp UpdateFirewallZoneResponse
+	if err := n.put(ctx, fmt.Sprintf("/v1/sites/%s/firewall/zones/%s", req.SiteID, req.ZoneID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type DeleteFirewallZoneRequest struct {
+	ZoneID string `json:"zoneId"`
+	SiteID string `json:"siteId"`
+}
+
+// DELETE /v1/sites/{siteId}/firewall/zones/{zoneId}
+
+// DeleteFirewallZone deletes a firewall zone.
+func (n *Network) DeleteFirewallZone(ctx context.Context, req *DeleteFirewallZoneRequest) error {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.ZoneID) == "" {
+		return errors.ErrEmptyZoneID
+	}
+
+	return n.delete(ctx, fmt.Sprintf("/v1/sites/%s/firewall/zones/%s", req.SiteID, req.ZoneID), nil)
+}
+
+// AssignNetworksToZone adds networks to a firewall zone, keeping the networks already assigned to it.
+func (n *Network) AssignNetworksToZone(ctx context.Context, siteID, zoneID string, networkIDs ...string) (*FirewallZone, error) {
+	zone, err := n.GetFirewallZone(ctx, &FirewallZoneDetailsRequest{SiteID: siteID, ZoneID: zoneID})
+	if err != nil {
+		return nil, err
+	}
+
+	assigned := make(map[string]bool, len(zone.NetworkIDs))
+	ids := append([]string(nil), zone.NetworkIDs...)
+	for _, id := range zone.NetworkIDs {
+		assigned[id] = true
+	}
+	for _, id := range networkIDs {
+		if !assigned[id] {
+			assigned[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	resp, err := n.UpdateFirewallZone(ctx, &UpdateFirewallZoneRequest{
+		ZoneID:     zoneID,
+		SiteID:     siteID,
+		Name:       zone.Name,
+		NetworkIDs: ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &resp.FirewallZone, nil
+}
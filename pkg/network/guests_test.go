@@ -0,0 +1,75 @@
+package network
+
+import (
+	"testing"
+	"time"
+)
+
+// TestGuestSessions tests the remaining allowance computed for authorized guests.
+func TestGuestSessions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	clients := []ConnectedClient{
+		{
+			ID: "guest-1",
+			Access: ConnectedClientAccess{
+				ConnectedClientAccessType: ConnectedClientAccessGuest,
+				Authorized:                true,
+				Athorization: ClientActionAuthorization{
+					ExpiresAt:            now.Add(90 * time.Minute).Format(time.RFC3339),
+					DataUsageLimitMBytes: 100,
+					Usage:                ClientActionAuthorizationUsage{Bytes: 40 << 20},
+				},
+			},
+		},
+		{
+			ID: "guest-expired",
+			Access: ConnectedClientAccess{
+				ConnectedClientAccessType: ConnectedClientAccessGuest,
+				Authorized:                true,
+				Athorization: ClientActionAuthorization{
+					ExpiresAt:            now.Add(-time.Minute).Format(time.RFC3339),
+					DataUsageLimitMBytes: 1,
+					Usage:                ClientActionAuthorizationUsage{Bytes: 2 << 20},
+				},
+			},
+		},
+		{
+			ID: "guest-unauthorized",
+			Access: ConnectedClientAccess{
+				ConnectedClientAccessType: ConnectedClientAccessGuest,
+			},
+		},
+		{
+			ID: "employee",
+			Access: ConnectedClientAccess{
+				ConnectedClientAccessType: ConnectedClientAccessDefault,
+				Authorized:                true,
+			},
+		},
+	}
+
+	sessions := guestSessions(clients, now)
+	if len(sessions) != 2 {
+		t.Fatalf("len(sessions) = %d, want 2", len(sessions))
+	}
+
+	if got := sessions[0].RemainingTime; got != 90*time.Minute {
+		t.Errorf("RemainingTime = %v, want %v", got, 90*time.Minute)
+	}
+	if !sessions[0].HasDataLimit || sessions[0].RemainingBytes != 60<<20 {
+		t.Errorf("RemainingBytes = %d (limited %v), want %d", sessions[0].RemainingBytes, sessions[0].HasDataLimit, 60<<20)
+	}
+	if !sessions[0].ExpiresAt.Equal(now.Add(90 * time.Minute)) {
+		t.Errorf("ExpiresAt = %v, want %v", sessions[0].ExpiresAt, now.Add(90*time.Minute))
+	}
+
+	if got := sessions[1].RemainingTime; got != 0 {
+		t.Errorf("RemainingTime = %v, want 0 for expired authorization", got)
+	}
+	if sessions[1].RemainingBytes != 0 {
+		t.Errorf("RemainingBytes = %d, want 0 for exhausted limit", sessions[1].RemainingBytes)
+	}
+}
@@ -0,0 +1,279 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// Legacy is a client for the classic controller API served under /api/s/{site}.
+// It covers data the integration API does not expose and controllers that only speak the classic API.
+// It shares the session, CSRF token and platform detection of the Network client that created it.
+type Legacy struct {
+	network *Network
+	site    string
+}
+
+// Legacy returns a client for the classic API of the site configured in Config.Site.
+func (n *Network) Legacy() *Legacy {
+	return n.LegacySite(n.site)
+}
+
+// LegacySite returns a client for the classic API of the given site name (e.g. "default").
+// Classic routes identify sites by their short name, not by the ID used by the integration API.
+func (n *Network) LegacySite(site string) *Legacy {
+	return &Legacy{network: n, site: site}
+}
+
+// Site returns the site name the client sends requests for.
+func (l *Legacy) Site() string {
+	return l.site
+}
+
+// legacyMeta is the status block of the classic API envelope.
+type legacyMeta struct {
+	RC  string `json:"rc"`
+	Msg string `json:"msg,omitempty"`
+}
+
+// legacyResponse is the {"meta":{"rc":"ok"},"data":[...]} envelope returned by classic routes.
+type legacyResponse struct {
+	Meta legacyMeta      `json:"meta"`
+	Data json.RawMessage `json:"data"`
+}
+
+// legacyPath returns the controller path of a classic API route.
+func (n *Network) legacyPath(path string) string {
+	if n.isUDM {
+		return "/proxy/network" + path
+	}
+	return path
+}
+
+// sitePath returns the classic API path of a site scoped route (e.g. "stat/health").
+func (l *Legacy) sitePath(endpoint string) (string, error) {
+	if strings.TrimSpace(l.site) == "" {
+		return "", errors.ErrEmptySiteID
+	}
+	return fmt.Sprintf("/api/s/%s/%s", l.site, endpoint), nil
+}
+
+func (l *Legacy) get(ctx context.Context, endpoint string, result interface{}) error {
+	path, err := l.sitePath(endpoint)
+	if err != nil {
+		return err
+	}
+	return l.network.legacy(ctx, http.MethodGet, path, nil, result)
+}
+
+func (l *Legacy) post(ctx context.Context, endpoint string, body, result interface{}) error {
+	path, err := l.sitePath(endpoint)
+	if err != nil {
+		return err
+	}
+	return l.network.legacy(ctx, http.MethodPost, path, body, result)
+}
+
+func (l *Legacy) put(ctx context.Context, endpoint string, body, result interface{}) error {
+	path, err := l.sitePath(endpoint)
+	if err != nil {
+		return err
+	}
+	return l.network.legacy(ctx, http.MethodPut, path, body, result)
+}
+
+// legacy sends a request to a classic API route and unwraps the data of the response envelope.
+// Envelopes with meta.rc == "error" are returned as *errors.APIError carrying meta.msg (e.g. "api.err.Invalid"),
+// and an expired session reported as api.err.LoginRequired is renewed like an HTTP 401.
+func (n *Network) legacy(ctx context.Context, method, path string, body, result interface{}) error {
+	if err := n.resolvePlatform(ctx); err != nil {
+		return err
+	}
+
+	path = n.legacyPath(path)
+	return n.withReauthentication(ctx, method, path, func() error {
+		return n.legacyOnce(ctx, method, path, body, result)
+	})
+}
+
+func (n *Network) legacyOnce(ctx context.Context, method, path string, body, result interface{}) error {
+	var envelope legacyResponse
+	if err := n.doOnce(ctx, method, path, body, &envelope); err != nil {
+		return legacyAPIError(err)
+	}
+
+	if envelope.Meta.RC == "error" {
+		return errors.NewAPIError(legacyErrorStatus(envelope.Meta.Msg), envelope.Meta.Msg, "")
+	}
+
+	if result != nil && len(envelope.Data) > 0 {
+		if err := json.Unmarshal(envelope.Data, result); err != nil {
+			return fmt.Errorf("failed to decode response data: %w", err)
+		}
+	}
+	return nil
+}
+
+// legacyErrorStatus returns the HTTP status matching the meta.msg of an error envelope served with 200 OK,
+// so errors.IsAuthenticationError and errors.IsNotFoundError recognize it.
+func legacyErrorStatus(msg string) int {
+	switch msg {
+	case "api.err.LoginRequired":
+		return http.StatusUnauthorized
+	case "api.err.NoSiteContext", "api.err.UnknownUser":
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
+
+// legacyAPIError replaces the raw body of an API error with the meta.msg of its classic envelope, if any.
+func legacyAPIError(err error) error {
+	var apiErr *errors.APIError
+	if !stderrors.As(err, &apiErr) {
+		return err
+	}
+
+	var envelope legacyResponse
+	if json.Unmarshal([]byte(apiErr.Message), &envelope) != nil || envelope.Meta.RC != "error" || envelope.Meta.Msg == "" {
+		return err
+	}
+	return errors.NewAPIError(apiErr.StatusCode, envelope.Meta.Msg, apiErr.RequestID)
+}
+
+// GET /api/self/sites
+
+// ListSites retrieves the sites the logged in user has access to, including their short name and description.
+func (l *Legacy) ListSites(ctx context.Context) ([]LegacySite, error) {
+	var sites []LegacySite
+	if err := l.network.legacy(ctx, http.MethodGet, "/api/self/sites", nil, &sites); err != nil {
+		return nil, err
+	}
+	return sites, nil
+}
+
+// GET /api/s/{site}/stat/health
+
+// GetSiteHealth retrieves the health of each subsystem (WAN, LAN, WLAN, VPN) of the site.
+func (l *Legacy) GetSiteHealth(ctx context.Context) ([]SiteHealth, error) {
+	var health []SiteHealth
+	if err := l.get(ctx, "stat/health", &health); err != nil {
+		return nil, err
+	}
+	return health, nil
+}
+
+// GET /api/s/{site}/stat/device
+
+// ListDevices retrieves the devices of the site with their full classic statistics.
+func (l *Legacy) ListDevices(ctx context.Context) ([]LegacyDevice, error) {
+	var devices []LegacyDevice
+	if err := l.get(ctx, "stat/device", &devices); err != nil {
+		return nil, err
+	}
+	return devices, nil
+}
+
+// GET /api/s/{site}/stat/sta
+
+// ListActiveClients retrieves the clients currently connected to the site.
+func (l *Legacy) ListActiveClients(ctx context.Context) ([]LegacyClient, error) {
+	var clients []LegacyClient
+	if err := l.get(ctx, "stat/sta", &clients); err != nil {
+		return nil, err
+	}
+	return clients, nil
+}
+
+// GET /api/s/{site}/stat/alluser
+
+// ListKnownClients retrieves every client the site has ever seen, including disconnected ones.
+func (l *Legacy) ListKnownClients(ctx context.Context) ([]LegacyClient, error) {
+	var clients []LegacyClient
+	if err := l.get(ctx, "stat/alluser", &clients); err != nil {
+		return nil, err
+	}
+	return clients, nil
+}
+
+// GET /api/s/{site}/rest/wlanconf
+
+// ListWLANConfs retrieves the WLAN configurations of the site.
+func (l *Legacy) ListWLANConfs(ctx context.Context) ([]LegacyWLANConf, error) {
+	var wlans []LegacyWLANConf
+	if err := l.get(ctx, "rest/wlanconf", &wlans); err != nil {
+		return nil, err
+	}
+	return wlans, nil
+}
+
+// PUT /api/s/{site}/rest/wlanconf/{id}
+
+// UpdateWLANConf updates the given fields of a WLAN configuration, e.g. {"enabled": false}.
+func (l *Legacy) UpdateWLANConf(ctx context.Context, id string, fields map[string]interface{}) (*LegacyWLANConf, error) {
+	if strings.TrimSpace(id) == "" {
+		return nil, errors.ErrEmptyWLANID
+	}
+
+	var wlans []LegacyWLANConf
+	if err := l.put(ctx, "rest/wlanconf/"+id, fields, &wlans); err != nil {
+		return nil, err
+	}
+	if len(wlans) == 0 {
+		return nil, fmt.Errorf("controller returned no WLAN configuration")
+	}
+	return &wlans[0], nil
+}
+
+// GET /api/s/{site}/rest/networkconf
+
+// ListNetworkConfs retrieves the network configurations of the site.
+func (l *Legacy) ListNetworkConfs(ctx context.Context) ([]LegacyNetworkConf, error) {
+	var networks []LegacyNetworkConf
+	if err := l.get(ctx, "rest/networkconf", &networks); err != nil {
+		return nil, err
+	}
+	return networks, nil
+}
+
+// PUT /api/s/{site}/rest/networkconf/{id}
+
+// UpdateNetworkConf updates the given fields of a network configuration, e.g. {"dhcpd_enabled": true}.
+func (l *Legacy) UpdateNetworkConf(ctx context.Context, id string, fields map[string]interface{}) (*LegacyNetworkConf, error) {
+	if strings.TrimSpace(id) == "" {
+		return nil, errors.ErrEmptyNetworkID
+	}
+
+	var networks []LegacyNetworkConf
+	if err := l.put(ctx, "rest/networkconf/"+id, fields, &networks); err != nil {
+		return nil, err
+	}
+	if len(networks) == 0 {
+		return nil, fmt.Errorf("controller returned no network configuration")
+	}
+	return &networks[0], nil
+}
+
+// POST /api/s/{site}/cmd/devmgr
+
+// DeviceCommand sends a device manager command (restart, locate, power-cycle, ...) to a device.
+func (l *Legacy) DeviceCommand(ctx context.Context, req *DeviceCommandRequest) error {
+	if req == nil {
+		return errors.NewValidationError("mac", "cannot be empty")
+	}
+	mac, err := NormalizeMAC(req.MAC)
+	if err != nil {
+		return err
+	}
+	if req.Command == "" {
+		return errors.NewValidationError("cmd", "cannot be empty")
+	}
+
+	body := *req
+	body.MAC = mac
+	return l.post(ctx, "cmd/devmgr", body, nil)
+}
@@ -0,0 +1,194 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	pkgerrors "github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// TestLegacy_GetSiteHealth tests unwrapping the classic API envelope on both platforms.
+func TestLegacy_GetSiteHealth(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		unifiOS  bool
+		wantPath string
+	}{
+		{name: "classic", wantPath: "/api/s/default/stat/health"},
+		{name: "unifi os", unifiOS: true, wantPath: "/proxy/network/api/s/default/stat/health"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/" {
+					if !tt.unifiOS {
+						http.Redirect(w, r, "/manage", http.StatusFound)
+					}
+					return
+				}
+				if r.URL.Path != tt.wantPath {
+					t.Errorf("path = %s, want %s", r.URL.Path, tt.wantPath)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"subsystem":"wan","status":"ok","num_gw":1},{"subsystem":"wlan","status":"warning","num_ap":3}]}`))
+			}))
+			defer server.Close()
+
+			client := newTestNetwork(t, server, Config{})
+			health, err := client.Legacy().GetSiteHealth(context.Background())
+			if err != nil {
+				t.Fatalf("GetSiteHealth() error = %v", err)
+			}
+			if len(health) != 2 || health[0].Subsystem != "wan" || health[1].NumAP != 3 {
+				t.Errorf("GetSiteHealth() = %+v", health)
+			}
+		})
+	}
+}
+
+// TestLegacy_Errors tests mapping meta.rc == "error" responses to API errors.
+func TestLegacy_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		status     int
+		msg        string
+		wantStatus int
+	}{
+		{name: "error status", status: http.StatusBadRequest, msg: "api.err.UnknownDevice", wantStatus: http.StatusBadRequest},
+		{name: "ok status", status: http.StatusOK, msg: "api.err.UnknownDevice", wantStatus: http.StatusBadRequest},
+		{name: "login required", status: http.StatusOK, msg: "api.err.LoginRequired", wantStatus: http.StatusUnauthorized},
+		{name: "no site context", status: http.StatusOK, msg: "api.err.NoSiteContext", wantStatus: http.StatusNotFound},
+		{name: "unknown user", status: http.StatusOK, msg: "api.err.UnknownUser", wantStatus: http.StatusNotFound},
+		{name: "unauthorized status", status: http.StatusUnauthorized, msg: "api.err.LoginRequired", wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/" {
+					http.Redirect(w, r, "/manage", http.StatusFound)
+					return
+				}
+				var body DeviceCommandRequest
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("failed to decode body: %v", err)
+				}
+				if body.Command != DeviceCommandRestart || body.MAC != "aa:bb:cc:dd:ee:ff" {
+					t.Errorf("body = %+v", body)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{"meta":{"rc":"error","msg":"` + tt.msg + `"},"data":[]}`))
+			}))
+			defer server.Close()
+
+			client := newTestNetwork(t, server, Config{})
+			err := client.Legacy().DeviceCommand(context.Background(), &DeviceCommandRequest{
+				Command: DeviceCommandRestart,
+				MAC:     "AA:BB:CC:DD:EE:FF",
+			})
+
+			var apiErr *pkgerrors.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected APIError, got %v", err)
+			}
+			if apiErr.StatusCode != tt.wantStatus || apiErr.Message != tt.msg {
+				t.Errorf("APIError = %+v", apiErr)
+			}
+			if tt.msg == "api.err.LoginRequired" && !pkgerrors.IsAuthenticationError(err) {
+				t.Errorf("IsAuthenticationError(%v) = false, want true", err)
+			}
+		})
+	}
+}
+
+// TestLegacy_ReauthenticateLoginRequired tests that a LoginRequired envelope renews the session.
+func TestLegacy_ReauthenticateLoginRequired(t *testing.T) {
+	t.Parallel()
+
+	var logins atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/":
+			w.WriteHeader(http.StatusOK)
+		case "/api/auth/login":
+			token := fmt.Sprintf("session-%d", logins.Add(1))
+			http.SetCookie(w, &http.Cookie{Name: "TOKEN", Value: token, Path: "/"})
+		case "/proxy/network/api/s/default/stat/health":
+			// The controller forgets the first session and reports it in a 200 envelope.
+			if cookie, err := r.Cookie("TOKEN"); err != nil || cookie.Value != "session-2" {
+				_, _ = w.Write([]byte(`{"meta":{"rc":"error","msg":"api.err.LoginRequired"},"data":[]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"subsystem":"wan","status":"ok"}]}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	n := newTestNetwork(t, server, Config{})
+	ctx := context.Background()
+	if err := n.Login(ctx, "admin", "pass"); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+
+	health, err := n.Legacy().GetSiteHealth(ctx)
+	if err != nil {
+		t.Fatalf("GetSiteHealth() error = %v", err)
+	}
+	if len(health) != 1 || health[0].Subsystem != "wan" {
+		t.Errorf("health = %+v", health)
+	}
+	if got := logins.Load(); got != 2 {
+		t.Errorf("logins = %d, want 2", got)
+	}
+}
+
+// TestLegacy_DeviceCommandValidation tests that device commands require a valid MAC address and a command.
+func TestLegacy_DeviceCommandValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]*DeviceCommandRequest{
+		"nil request":   nil,
+		"empty MAC":     {Command: DeviceCommandRestart},
+		"invalid MAC":   {Command: DeviceCommandRestart, MAC: "not-a-mac"},
+		"empty command": {MAC: "aa:bb:cc:dd:ee:ff"},
+	}
+	for name, req := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/" {
+					http.Redirect(w, r, "/manage", http.StatusFound)
+					return
+				}
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}))
+			defer server.Close()
+
+			err := newTestNetwork(t, server, Config{}).Legacy().DeviceCommand(context.Background(), req)
+			if !pkgerrors.IsValidationError(err) {
+				t.Errorf("DeviceCommand() error = %v, want validation error", err)
+			}
+		})
+	}
+}
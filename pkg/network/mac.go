@@ -0,0 +1,34 @@
+package network
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// NormalizeMAC converts a MAC address written with colons, dashes, dots or no separators
+// (e.g. "AA-BB-CC-DD-EE-FF", "aabb.ccdd.eeff", "AABBCCDDEEFF") to the lower-case colon form used by the controller.
+func NormalizeMAC(mac string) (string, error) {
+	hex := strings.NewReplacer(":", "", "-", "", ".", "").Replace(strings.TrimSpace(mac))
+	if len(hex) != 12 {
+		return "", errors.NewValidationError("mac", fmt.Sprintf("invalid MAC address %q", mac))
+	}
+
+	hex = strings.ToLower(hex)
+	var b strings.Builder
+	for i := 0; i < 12; i += 2 {
+		if !isHexDigit(hex[i]) || !isHexDigit(hex[i+1]) {
+			return "", errors.NewValidationError("mac", fmt.Sprintf("invalid MAC address %q", mac))
+		}
+		if i > 0 {
+			b.WriteByte(':')
+		}
+		b.WriteString(hex[i : i+2])
+	}
+	return b.String(), nil
+}
+
+func isHexDigit(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f')
+}
@@ -3,12 +3,25 @@
 package network
 
 import (
+	"bytes"
+	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
 	"net/http/cookiejar"
+	"net/url"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
+
+	"github.com/ilmax/unifi-client-go/internal/logging"
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+	"github.com/ilmax/unifi-client-go/pkg/middleware"
 )
 
 const (
@@ -17,11 +30,27 @@ const (
 
 // Network is used to interact with the UniFi Network API.
 type Network struct {
-	httpClient *http.Client
-	baseURL    string
-	site       string
-	csrfToken  string
-	isUDM      bool
+	httpClient  *http.Client
+	doer        middleware.Doer
+	middlewares []middleware.Middleware
+	logger      *slog.Logger
+	baseURL     string
+	site        string
+	apiKey      string
+	isUDM       bool
+
+	mu        sync.RWMutex
+	csrfToken string
+
+	credentials CredentialsFunc
+	authMu      sync.Mutex
+	session     atomic.Uint64
+	username    string
+	password    string
+	remember    bool
+
+	platformMu       sync.Mutex
+	platformResolved bool
 }
 
 // Config contains configuration for the Network client.
@@ -34,6 +63,17 @@ type Config struct {
 	Timeout time.Duration
 	// InsecureSkipVerify skips TLS certificate verification (useful for self-signed certs)
 	InsecureSkipVerify bool
+	// APIKey authenticates requests with the X-API-KEY header instead of a username/password session
+	APIKey string
+	// Credentials supplies the credentials used to renew an expired session (optional).
+	// When nil, the credentials passed to Login are reused.
+	Credentials CredentialsFunc
+	// Middlewares wrap every request sent to the controller, the first one being the outermost (optional).
+	// The WebSocket event stream is not wrapped.
+	Middlewares []middleware.Middleware
+	// Logger receives a debug record for every request (optional).
+	// API keys, cookies, CSRF tokens and passwords are always redacted.
+	Logger *slog.Logger
 }
 
 // New creates a new Network client.
@@ -61,13 +101,228 @@ func New(cfg Config) (*Network, error) {
 		},
 	}
 
+	httpClient := &http.Client{
+		Timeout:   cfg.Timeout,
+		Jar:       jar,
+		Transport: transport,
+	}
+
 	return &Network{
-		httpClient: &http.Client{
-			Timeout:   cfg.Timeout,
-			Jar:       jar,
-			Transport: transport,
-		},
-		baseURL: strings.TrimSuffix(cfg.BaseURL, "/"),
-		site:    cfg.Site,
+		httpClient:  httpClient,
+		doer:        middleware.Chain(httpClient, cfg.Middlewares...),
+		middlewares: cfg.Middlewares,
+		logger:      cfg.Logger,
+		baseURL:     strings.TrimSuffix(cfg.BaseURL, "/"),
+		site:        cfg.Site,
+		apiKey:      strings.TrimSpace(cfg.APIKey),
+		credentials: cfg.Credentials,
 	}, nil
 }
+
+// resolvePlatform determines whether the controller runs UniFi OS.
+// The result is cached, so the controller is only probed once.
+func (n *Network) resolvePlatform(ctx context.Context) error {
+	n.platformMu.Lock()
+	defer n.platformMu.Unlock()
+
+	if n.platformResolved {
+		return nil
+	}
+
+	isUDM, err := n.probeUniFiOS(ctx)
+	if err != nil {
+		return err
+	}
+
+	n.isUDM = isUDM
+	n.platformResolved = true
+	return nil
+}
+
+// probeUniFiOS reports whether the controller is a UniFi OS console.
+// UniFi OS consoles answer the root path directly, while classic controllers redirect to the login page.
+func (n *Network) probeUniFiOS(ctx context.Context) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, n.baseURL+"/", nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	client := *n.httpClient
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	resp, err := middleware.Chain(&client, n.middlewares...).Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to detect controller type: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	return resp.StatusCode == http.StatusOK, nil
+}
+
+// integrationPath returns the controller path of an integration API route.
+func (n *Network) integrationPath(path string) string {
+	if n.isUDM {
+		return "/proxy/network/integration" + path
+	}
+	return "/integration" + path
+}
+
+func (n *Network) get(ctx context.Context, path string, result interface{}) error {
+	return n.integration(ctx, http.MethodGet, path, nil, result)
+}
+
+func (n *Network) post(ctx context.Context, path string, body, result interface{}) error {
+	return n.integration(ctx, http.MethodPost, path, body, result)
+}
+
+func (n *Network) put(ctx context.Context, path string, body, result interface{}) error {
+	return n.integration(ctx, http.MethodPut, path, body, result)
+}
+
+func (n *Network) delete(ctx context.Context, path string, result interface{}) error {
+	return n.integration(ctx, http.MethodDelete, path, nil, result)
+}
+
+// integration sends a request to an integration API route.
+func (n *Network) integration(ctx context.Context, method, path string, body, result interface{}) error {
+	if err := n.resolvePlatform(ctx); err != nil {
+		return err
+	}
+	return n.do(ctx, method, n.integrationPath(path), body, result)
+}
+
+// do sends a request and transparently renews an expired session once before giving up.
+func (n *Network) do(ctx context.Context, method, path string, body, result interface{}) error {
+	return n.withReauthentication(ctx, method, path, func() error {
+		return n.doOnce(ctx, method, path, body, result)
+	})
+}
+
+// withReauthentication calls send and, if it fails with an authentication error, renews the session
+// and calls it once more.
+func (n *Network) withReauthentication(ctx context.Context, method, path string, send func() error) error {
+	session := n.session.Load()
+
+	err := send()
+	if !errors.IsAuthenticationError(err) || !n.canReauthenticate() {
+		return err
+	}
+
+	if n.logger != nil {
+		n.logger.DebugContext(ctx, "unifi session expired, re-authenticating", slog.String("method", method), slog.String("path", path))
+	}
+	if err := n.reauthenticate(ctx, session); err != nil {
+		return err
+	}
+	return send()
+}
+
+func (n *Network) doOnce(ctx context.Context, method, path string, body, result interface{}) error {
+	url := n.baseURL + path
+
+	var bodyReader io.Reader
+	var jsonBody []byte
+	if body != nil {
+		var err error
+		jsonBody, err = json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		bodyReader = bytes.NewReader(jsonBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if n.apiKey != "" {
+		req.Header.Set("X-API-KEY", n.apiKey)
+	}
+	if token := n.getCSRFToken(); token != "" && isMutating(method) {
+		req.Header.Set("X-CSRF-Token", token)
+	}
+
+	start := time.Now()
+	entry := logging.Request{Method: method, Path: path, Header: req.Header, Body: jsonBody}
+	defer func() {
+		entry.Duration = time.Since(start)
+		logging.LogRequest(ctx, n.logger, entry)
+	}()
+
+	resp, err := n.doer.Do(req)
+	if err != nil {
+		entry.Err = err
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	entry.Status = resp.StatusCode
+	entry.RequestID = resp.Header.Get("X-Request-Id")
+
+	n.updateCSRFToken(resp.Header)
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		entry.Err = err
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return errors.NewAPIError(
+			resp.StatusCode,
+			string(respBody),
+			resp.Header.Get("X-Request-Id"),
+		)
+	}
+
+	if result != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// pageQuery converts the pagination and filter parameters shared by list endpoints to a URL query string.
+func pageQuery(offset, limit int, filter string) string {
+	params := url.Values{}
+	if offset > 0 {
+		params.Set("offset", strconv.Itoa(offset))
+	}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+	if filter != "" {
+		params.Set("filter", filter)
+	}
+	if len(params) == 0 {
+		return ""
+	}
+	return "?" + params.Encode()
+}
+
+// pageLimit is the page size used when collecting every page of a list endpoint.
+const pageLimit = 200
+
+// listAll collects every page of a paginated list endpoint.
+// fetch returns the items at the given offset and the total number of items.
+func listAll[T any](fetch func(offset int) ([]T, int, error)) ([]T, error) {
+	var all []T
+	for {
+		items, total, err := fetch(len(all))
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if len(items) == 0 || len(all) >= total {
+			return all, nil
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pkgerrors "github.com/ilmax/unifi-client-go/pkg/errors"
+	"github.com/ilmax/unifi-client-go/pkg/middleware"
+)
+
+// newTestNetwork creates a Network client pointing at the given test server.
+func newTestNetwork(t *testing.T, server *httptest.Server, cfg Config) *Network {
+	t.Helper()
+
+	cfg.BaseURL = server.URL
+	n, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return n
+}
+
+// TestNew tests the New function.
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	if _, err := New(Config{}); err == nil {
+		t.Error("expected error for empty BaseURL, got nil")
+	}
+
+	n, err := New(Config{BaseURL: "https://192.168.1.1/"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n.baseURL != "https://192.168.1.1" {
+		t.Errorf("baseURL = %q, want %q", n.baseURL, "https://192.168.1.1")
+	}
+	if n.site != "default" {
+		t.Errorf("site = %q, want %q", n.site, "default")
+	}
+}
+
+// TestNetwork_ListClients tests path substitution, query encoding and envelope decoding.
+func TestNetwork_ListClients(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			http.Redirect(w, r, "/manage", http.StatusFound)
+			return
+		}
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET method, got %s", r.Method)
+		}
+		if r.URL.Path != "/integration/v1/sites/site-1/clients" {
+			t.Errorf("path = %q, want %q", r.URL.Path, "/integration/v1/sites/site-1/clients")
+		}
+		if got := r.URL.Query().Get("limit"); got != "25" {
+			t.Errorf("limit = %q, want %q", got, "25")
+		}
+		if got := r.URL.Query().Get("filter"); got != "type.eq('WIRED')" {
+			t.Errorf("filter = %q, want %q", got, "type.eq('WIRED')")
+		}
+		if r.URL.Query().Has("offset") {
+			t.Error("offset should be omitted when zero")
+		}
+		json.NewEncoder(w).Encode(ConnectedClientsResponse{
+			Limit:      25,
+			Count:      1,
+			TotalCount: 1,
+			Data:       []ConnectedClient{{ID: "client-1", ConnectedType: ConnectedClientTypeWired}},
+		})
+	}))
+	defer server.Close()
+
+	n := newTestNetwork(t, server, Config{})
+
+	resp, err := n.ListClients(context.Background(), &ConnectedClientsRequest{
+		SiteID: "site-1",
+		Limit:  25,
+		Filter: "type.eq('WIRED')",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.TotalCount != 1 || len(resp.Data) != 1 || resp.Data[0].ID != "client-1" {
+		t.Errorf("response = %+v, want one client with ID client-1", resp)
+	}
+}
+
+// TestNetwork_GetDevice tests parameter validation and API errors.
+func TestNetwork_GetDevice(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("not found"))
+	}))
+	defer server.Close()
+
+	n := newTestNetwork(t, server, Config{})
+	ctx := context.Background()
+
+	if _, err := n.GetDevice(ctx, nil); !errors.Is(err, pkgerrors.ErrEmptySiteID) {
+		t.Errorf("error = %v, want %v", err, pkgerrors.ErrEmptySiteID)
+	}
+	if _, err := n.GetDevice(ctx, &AdoptDeviceDetailRequest{SiteID: "site-1"}); !errors.Is(err, pkgerrors.ErrEmptyDeviceID) {
+		t.Errorf("error = %v, want %v", err, pkgerrors.ErrEmptyDeviceID)
+	}
+
+	_, err := n.GetDevice(ctx, &AdoptDeviceDetailRequest{SiteID: "site-1", DeviceID: "missing"})
+	if !pkgerrors.IsNotFoundError(err) {
+		t.Errorf("error = %v, want not found API error", err)
+	}
+}
+
+// TestNetwork_APIKey tests API key authentication against a UniFi OS console.
+func TestNetwork_APIKey(t *testing.T) {
+	t.Parallel()
+
+	var probes int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			probes++
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path != "/proxy/network/integration/v1/info" {
+			t.Errorf("path = %q, want %q", r.URL.Path, "/proxy/network/integration/v1/info")
+		}
+		if got := r.Header.Get("X-API-KEY"); got != "secret-key" {
+			t.Errorf("X-API-KEY = %q, want %q", got, "secret-key")
+		}
+		json.NewEncoder(w).Encode(GetApplicationInfoResponse{ApplicationVersion: "9.1.120"})
+	}))
+	defer server.Close()
+
+	n := newTestNetwork(t, server, Config{APIKey: "  secret-key "})
+
+	for i := 0; i < 2; i++ {
+		info, err := n.GetApplicationInfo(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if info.ApplicationVersion != "9.1.120" {
+			t.Errorf("ApplicationVersion = %q, want %q", info.ApplicationVersion, "9.1.120")
+		}
+	}
+	if probes != 1 {
+		t.Errorf("controller probed %d times, want 1", probes)
+	}
+}
+
+// TestNetwork_Middlewares tests that middlewares wrap every controller request, including the platform probe.
+func TestNetwork_Middlewares(t *testing.T) {
+	t.Parallel()
+
+	var paths []string
+	stub := func(middleware.Doer) middleware.Doer {
+		return middleware.DoerFunc(func(req *http.Request) (*http.Response, error) {
+			paths = append(paths, req.Method+" "+req.URL.Path)
+			body := `{"applicationVersion":"9.0.0"}`
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+		})
+	}
+
+	n, err := New(Config{
+		BaseURL:     "https://unifi.invalid",
+		Middlewares: []middleware.Middleware{stub},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	info, err := n.GetApplicationInfo(context.Background())
+	if err != nil {
+		t.Fatalf("GetApplicationInfo() error = %v", err)
+	}
+	if info.ApplicationVersion != "9.0.0" {
+		t.Errorf("ApplicationVersion = %q, want %q", info.ApplicationVersion, "9.0.0")
+	}
+
+	want := "GET /,GET /proxy/network/integration/v1/info"
+	if got := strings.Join(paths, ","); got != want {
+		t.Errorf("requests = %s, want %s", got, want)
+	}
+}
@@ -1,5 +1,14 @@
 package network
 
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
 type NetworkDetailsRequest struct {
 	NetworkID string `json:"networkId"`
 	SiteID    string `json:"siteId"`
@@ -11,9 +20,25 @@ type NetworkDetailsResponse struct {
 
 // GET /v1/sites/{siteId}/networks/{networkId}
 
+// GetNetwork retrieves the details of a single network.
+func (n *Network) GetNetwork(ctx context.Context, req *NetworkDetailsRequest) (*NetworkDetailsResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.NetworkID) == "" {
+		return nil, errors.ErrEmptyNetworkID
+	}
+
+	var resp NetworkDetailsResponse
+	if err := n.get(ctx, fmt.Sprintf("/v1/sites/%s/networks/%s", req.SiteID, req.NetworkID), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 type UpdateNetworkRequest struct {
-	NetworkID string `json:"networkId"`
-	SiteID    string `json:"siteId"`
+	NetworkID string `json:"-"`
+	SiteID    string `json:"-"`
 
 	Management   NetworkManagementType `json:"management"`
 	Name         string                `json:"name"`
@@ -37,15 +62,102 @@ type UpdateNetworkResponse struct {
 
 // PUT /v1/sites/{siteId}/networks/{networkId}
 
-type DeleteNetworkResponse struct {
+// UpdateNetwork updates an existing network.
+func (n *Network) UpdateNetwork(ctx context.Context, req *UpdateNetworkRequest) (*UpdateNetworkResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.NetworkID) == "" {
+		return nil, errors.ErrEmptyNetworkID
+	}
+
+	var resp UpdateNetworkResponse
+	if err := n.put(ctx, fmt.Sprintf("/v1/sites/%s/networks/%s", req.SiteID, req.NetworkID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type DeleteNetworkRequest struct {
 	NetworkID string `json:"networkId"`
 	SiteID    string `json:"siteId"`
 	Cascade   bool   `json:"cascade"`
 	Force     bool   `json:"force"`
 }
 
+// ToQuery converts the request to URL query string.
+func (r *DeleteNetworkRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	params := url.Values{}
+	if r.Cascade {
+		params.Set("cascade", "true")
+	}
+	if r.Force {
+		params.Set("force", "true")
+	}
+	if len(params) == 0 {
+		return ""
+	}
+	return "?" + params.Encode()
+}
+
+// DeleteNetworkResponse contains the parameters of a network deletion.
+//
+// Deprecated: use DeleteNetworkRequest.
+type DeleteNetworkResponse = DeleteNetworkRequest
+
 // DELETE /v1/sites/{siteId}/networks/{networkId}
 
+// DeleteNetwork deletes a network.
+// Cascade also deletes the resources that reference the network, and Force deletes it even if it is in use.
+func (n *Network) DeleteNetwork(ctx context.Context, req *DeleteNetworkRequest) error {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.NetworkID) == "" {
+		return errors.ErrEmptyNetworkID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/networks/%s", req.SiteID, req.NetworkID) + req.ToQuery()
+	return n.delete(ctx, path, nil)
+}
+
+// SafeDeleteNetwork deletes a network only if it is not referenced by WLANs or port profiles.
+// The reference check is skipped when Force is set.
+// An error wrapping errors.ErrNetworkInUse is returned if the network is still in use.
+func (n *Network) SafeDeleteNetwork(ctx context.Context, req *DeleteNetworkRequest) error {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.NetworkID) == "" {
+		return errors.ErrEmptyNetworkID
+	}
+
+	if !req.Force {
+		refs, err := n.GetNetworkReferences(ctx, &NetworkReferencesRequest{
+			NetworkID: req.NetworkID,
+			SiteID:    req.SiteID,
+		})
+		if err != nil {
+			return err
+		}
+
+		var blocking []string
+		for _, res := range refs.ReferenceResources {
+			if res.ReferenceCount > 0 && res.ResourceType.blocksDeletion() {
+				blocking = append(blocking, fmt.Sprintf("%d %s", res.ReferenceCount, res.ResourceType))
+			}
+		}
+		if len(blocking) > 0 {
+			return fmt.Errorf("%w: network %s is used by %s", errors.ErrNetworkInUse, req.NetworkID, strings.Join(blocking, ", "))
+		}
+	}
+
+	return n.DeleteNetwork(ctx, req)
+}
+
 type ListNetworksRequest struct {
 	SiteID string `json:"siteId"`
 
@@ -54,6 +166,14 @@ type ListNetworksRequest struct {
 	Filter string `json:"filter"`
 }
 
+// ToQuery converts the request to URL query string.
+func (r *ListNetworksRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
 type ListNetworksResponse struct {
 	Offset     int             `json:"offset"`
 	Limit      int             `json:"limit"`
@@ -64,9 +184,24 @@ type ListNetworksResponse struct {
 
 // GET /v1/sites/{siteId}/networks
 
+// ListNetworks retrieves the networks of a site.
+func (n *Network) ListNetworks(ctx context.Context, req *ListNetworksRequest) (*ListNetworksResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/networks", req.SiteID) + req.ToQuery()
+
+	var resp ListNetworksResponse
+	if err := n.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 type CreateNetworkRequest struct {
-	NetworkID string `json:"networkId"`
-	SiteID    string `json:"siteId"`
+	NetworkID string `json:"-"`
+	SiteID    string `json:"-"`
 
 	Management   NetworkManagementType `json:"management"`
 	Name         string                `json:"name"`
@@ -90,6 +225,19 @@ type CreateNetworkResponse struct {
 
 // POST /v1/sites/{siteId}/networks
 
+// CreateNetwork creates a new network.
+func (n *Network) CreateNetwork(ctx context.Context, req *CreateNetworkRequest) (*CreateNetworkResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	var resp CreateNetworkResponse
+	if err := n.post(ctx, fmt.Sprintf("/v1/sites/%s/networks", req.SiteID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 type NetworkReferencesRequest struct {
 	NetworkID string `json:"networkId"`
 	SiteID    string `json:"siteId"`
@@ -99,8 +247,20 @@ type NetworkReferencesResponse struct {
 	ReferenceResources []ReferenceResource `json:"referenceResources"`
 }
 
+type ReferenceResourceType string
+
+const (
+	ReferenceResourceTypeWLAN        ReferenceResourceType = "WLAN"
+	ReferenceResourceTypePortProfile ReferenceResourceType = "PORT_PROFILE"
+)
+
+// blocksDeletion reports whether references of this type prevent a safe network deletion.
+func (t ReferenceResourceType) blocksDeletion() bool {
+	return t == ReferenceResourceTypeWLAN || t == ReferenceResourceTypePortProfile
+}
+
 type ReferenceResource struct {
-	ResourceType   string                       `json:"resourceType"`
+	ResourceType   ReferenceResourceType        `json:"resourceType"`
 	ReferenceCount int                          `json:"referenceCount"`
 	References     []ReferenceResourceReference `json:"references"`
 }
@@ -110,3 +270,21 @@ type ReferenceResourceReference struct {
 }
 
 // GET /v1/sites/{siteId}/networks/{networkId}/references
+
+// GetNetworkReferences retrieves the resources that reference a network.
+func (n *Network) GetNetworkReferences(ctx context.Context, req *NetworkReferencesRequest) (*NetworkReferencesResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.NetworkID) == "" {
+		return nil, errors.ErrEmptyNetworkID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/networks/%s/references", req.SiteID, req.NetworkID)
+
+	var resp NetworkReferencesResponse
+	if err := n.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
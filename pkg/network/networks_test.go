@@ -0,0 +1,97 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pkgerrors "github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// TestNetwork_SafeDeleteNetwork tests that networks still in use are only deleted when forced.
+func TestNetwork_SafeDeleteNetwork(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		references []ReferenceResource
+		force      bool
+		wantDelete bool
+		wantQuery  string
+		wantErr    error
+	}{
+		{
+			name:       "unused network is deleted",
+			references: nil,
+			wantDelete: true,
+		},
+		{
+			name: "network used by WLAN is refused",
+			references: []ReferenceResource{
+				{ResourceType: ReferenceResourceTypeWLAN, ReferenceCount: 2},
+			},
+			wantErr: pkgerrors.ErrNetworkInUse,
+		},
+		{
+			name: "other references do not block deletion",
+			references: []ReferenceResource{
+				{ResourceType: "CLIENT", ReferenceCount: 5},
+				{ResourceType: ReferenceResourceTypePortProfile, ReferenceCount: 0},
+			},
+			wantDelete: true,
+		},
+		{
+			name: "force skips the reference check",
+			references: []ReferenceResource{
+				{ResourceType: ReferenceResourceTypePortProfile, ReferenceCount: 1},
+			},
+			force:      true,
+			wantDelete: true,
+			wantQuery:  "force=true",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var deleted bool
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/":
+					w.WriteHeader(http.StatusOK)
+				case r.URL.Path == "/proxy/network/integration/v1/sites/site-1/networks/net-1/references":
+					if tt.force {
+						t.Error("references should not be checked when forced")
+					}
+					json.NewEncoder(w).Encode(NetworkReferencesResponse{ReferenceResources: tt.references})
+				case r.URL.Path == "/proxy/network/integration/v1/sites/site-1/networks/net-1" && r.Method == http.MethodDelete:
+					deleted = true
+					if r.URL.RawQuery != tt.wantQuery {
+						t.Errorf("query = %q, want %q", r.URL.RawQuery, tt.wantQuery)
+					}
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			n := newTestNetwork(t, server, Config{APIKey: "key"})
+
+			err := n.SafeDeleteNetwork(context.Background(), &DeleteNetworkRequest{
+				SiteID:    "site-1",
+				NetworkID: "net-1",
+				Force:     tt.force,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+			if deleted != tt.wantDelete {
+				t.Errorf("deleted = %v, want %v", deleted, tt.wantDelete)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ReferenceCatalog is an in-memory snapshot of the DPI categories, DPI applications and countries
+// known to the controller. It is safe for concurrent use; load it once and reuse it to render and
+// validate application and region filters. Names are matched case-insensitively; when several entries
+// share a name or ID the first one listed wins, as in Resolver.
+type ReferenceCatalog struct {
+	categories   []DPICategory
+	applications []DPIApplication
+	countries    []Country
+
+	categoriesByID     map[int]DPICategory
+	categoriesByName   map[string]DPICategory
+	applicationsByID   map[int]DPIApplication
+	applicationsByName map[string]DPIApplication
+	countriesByCode    map[string]Country
+	countriesByName    map[string]Country
+}
+
+// LoadReferenceCatalog fetches every page of the DPI category, DPI application and country catalogs.
+func (n *Network) LoadReferenceCatalog(ctx context.Context) (*ReferenceCatalog, error) {
+	categories, err := listAll(func(offset int) ([]DPICategory, int, error) {
+		resp, err := n.ListDPICategories(ctx, &ListDPICategoriesRequest{Offset: offset, Limit: pageLimit})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Data, resp.TotalCount, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DPI categories: %w", err)
+	}
+
+	applications, err := listAll(func(offset int) ([]DPIApplication, int, error) {
+		resp, err := n.ListDPIApplications(ctx, &ListDPIApplicationsRequest{Offset: offset, Limit: pageLimit})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Data, resp.TotalCount, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DPI applications: %w", err)
+	}
+
+	countries, err := listAll(func(offset int) ([]Country, int, error) {
+		resp, err := n.ListCountries(ctx, &ListCountriesRequest{Offset: offset, Limit: pageLimit})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Data, resp.TotalCount, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list countries: %w", err)
+	}
+
+	return NewReferenceCatalog(categories, applications, countries), nil
+}
+
+// NewReferenceCatalog builds a catalog from already fetched entries, e.g. a cached copy stored on disk.
+func NewReferenceCatalog(categories []DPICategory, applications []DPIApplication, countries []Country) *ReferenceCatalog {
+	c := &ReferenceCatalog{
+		categories:         categories,
+		applications:       applications,
+		countries:          countries,
+		categoriesByID:     make(map[int]DPICategory, len(categories)),
+		categoriesByName:   make(map[string]DPICategory, len(categories)),
+		applicationsByID:   make(map[int]DPIApplication, len(applications)),
+		applicationsByName: make(map[string]DPIApplication, len(applications)),
+		countriesByCode:    make(map[string]Country, len(countries)),
+		countriesByName:    make(map[string]Country, len(countries)),
+	}
+
+	for _, category := range categories {
+		addFirst(c.categoriesByID, category.ID, category)
+		addFirst(c.categoriesByName, strings.ToLower(category.Name), category)
+	}
+	for _, application := range applications {
+		addFirst(c.applicationsByID, application.ID, application)
+		addFirst(c.applicationsByName, strings.ToLower(application.Name), application)
+	}
+	for _, country := range countries {
+		addFirst(c.countriesByCode, strings.ToUpper(country.Code), country)
+		addFirst(c.countriesByName, strings.ToLower(country.Name), country)
+	}
+	return c
+}
+
+// addFirst adds value under key unless the key is already taken.
+func addFirst[K comparable, V any](index map[K]V, key K, value V) {
+	if _, ok := index[key]; !ok {
+		index[key] = value
+	}
+}
+
+// DPICategories returns every DPI category in the catalog.
+func (c *ReferenceCatalog) DPICategories() []DPICategory {
+	return c.categories
+}
+
+// DPIApplications returns every DPI application in the catalog.
+func (c *ReferenceCatalog) DPIApplications() []DPIApplication {
+	return c.applications
+}
+
+// Countries returns every country in the catalog.
+func (c *ReferenceCatalog) Countries() []Country {
+	return c.countries
+}
+
+// DPICategoryByID looks up a DPI category by ID.
+func (c *ReferenceCatalog) DPICategoryByID(id int) (DPICategory, bool) {
+	category, ok := c.categoriesByID[id]
+	return category, ok
+}
+
+// DPICategoryByName looks up a DPI category by name.
+func (c *ReferenceCatalog) DPICategoryByName(name string) (DPICategory, bool) {
+	category, ok := c.categoriesByName[strings.ToLower(name)]
+	return category, ok
+}
+
+// DPIApplicationByID looks up a DPI application by ID.
+func (c *ReferenceCatalog) DPIApplicationByID(id int) (DPIApplication, bool) {
+	application, ok := c.applicationsByID[id]
+	return application, ok
+}
+
+// DPIApplicationByName looks up a DPI application by name.
+func (c *ReferenceCatalog) DPIApplicationByName(name string) (DPIApplication, bool) {
+	application, ok := c.applicationsByName[strings.ToLower(name)]
+	return application, ok
+}
+
+// CountryByCode looks up a country by its ISO 3166-1 alpha-2 code.
+func (c *ReferenceCatalog) CountryByCode(code string) (Country, bool) {
+	country, ok := c.countriesByCode[strings.ToUpper(code)]
+	return country, ok
+}
+
+// CountryByName looks up a country by name.
+func (c *ReferenceCatalog) CountryByName(name string) (Country, bool) {
+	country, ok := c.countriesByName[strings.ToLower(name)]
+	return country, ok
+}
@@ -0,0 +1,85 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestNetwork_LoadReferenceCatalog tests loading and querying the reference catalog.
+func TestNetwork_LoadReferenceCatalog(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/":
+			http.Redirect(w, r, "/manage", http.StatusFound)
+		case "/integration/v1/dpi/categories":
+			_, _ = w.Write([]byte(`{"totalCount":1,"data":[{"id":4,"name":"Streaming Media"}]}`))
+		case "/integration/v1/dpi/applications":
+			// Serve the applications in two pages.
+			if r.URL.Query().Get("offset") == "1" {
+				_, _ = w.Write([]byte(`{"offset":1,"totalCount":2,"data":[{"id":262201,"name":"YouTube"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"offset":0,"totalCount":2,"data":[{"id":262256,"name":"Netflix"}]}`))
+		case "/integration/v1/countries":
+			_, _ = w.Write([]byte(`{"totalCount":2,"data":[{"code":"IT","name":"Italy"},{"code":"US","name":"United States"}]}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestNetwork(t, server, Config{})
+	catalog, err := client.LoadReferenceCatalog(context.Background())
+	if err != nil {
+		t.Fatalf("LoadReferenceCatalog() error = %v", err)
+	}
+
+	if n := len(catalog.DPIApplications()); n != 2 {
+		t.Errorf("DPIApplications() = %d entries, want 2", n)
+	}
+	if app, ok := catalog.DPIApplicationByName("youtube"); !ok || app.ID != 262201 {
+		t.Errorf("DPIApplicationByName() = %+v, %v", app, ok)
+	}
+	if app, ok := catalog.DPIApplicationByID(262256); !ok || app.Name != "Netflix" {
+		t.Errorf("DPIApplicationByID() = %+v, %v", app, ok)
+	}
+	if category, ok := catalog.DPICategoryByName("Streaming Media"); !ok || category.ID != 4 {
+		t.Errorf("DPICategoryByName() = %+v, %v", category, ok)
+	}
+	if country, ok := catalog.CountryByCode("it"); !ok || country.Name != "Italy" {
+		t.Errorf("CountryByCode() = %+v, %v", country, ok)
+	}
+	if country, ok := catalog.CountryByName("united states"); !ok || country.Code != "US" {
+		t.Errorf("CountryByName() = %+v, %v", country, ok)
+	}
+	if _, ok := catalog.DPICategoryByID(99); ok {
+		t.Error("DPICategoryByID(99) found an unknown category")
+	}
+}
+
+// TestNewReferenceCatalogDuplicates tests that the first of several entries sharing a name wins.
+func TestNewReferenceCatalogDuplicates(t *testing.T) {
+	t.Parallel()
+
+	catalog := NewReferenceCatalog(
+		[]DPICategory{{ID: 4, Name: "Streaming Media"}, {ID: 5, Name: "streaming media"}},
+		[]DPIApplication{{ID: 1, Name: "Teams"}, {ID: 2, Name: "TEAMS"}},
+		[]Country{{Code: "IT", Name: "Italy"}, {Code: "it", Name: "Italia"}},
+	)
+
+	if category, ok := catalog.DPICategoryByName("streaming media"); !ok || category.ID != 4 {
+		t.Errorf("DPICategoryByName() = %+v, %v, want ID 4", category, ok)
+	}
+	if app, ok := catalog.DPIApplicationByName("teams"); !ok || app.ID != 1 {
+		t.Errorf("DPIApplicationByName() = %+v, %v, want ID 1", app, ok)
+	}
+	if country, ok := catalog.CountryByCode("IT"); !ok || country.Name != "Italy" {
+		t.Errorf("CountryByCode() = %+v, %v, want Italy", country, ok)
+	}
+}
@@ -0,0 +1,104 @@
+package network
+
+import (
+	"context"
+)
+
+type ListDPICategoriesRequest struct {
+	Offset int    `json:"offset"`
+	Limit  int    `json:"limit"`
+	Filter string `json:"filter"`
+}
+
+// ToQuery converts the request to URL query string.
+func (r *ListDPICategoriesRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
+type ListDPICategoriesResponse struct {
+	Offset     int           `json:"offset"`
+	Limit      int           `json:"limit"`
+	Count      int           `json:"count"`
+	TotalCount int           `json:"totalCount"`
+	Data       []DPICategory `json:"data"`
+}
+
+// GET /v1/dpi/categories
+
+// ListDPICategories retrieves the DPI categories.
+func (n *Network) ListDPICategories(ctx context.Context, req *ListDPICategoriesRequest) (*ListDPICategoriesResponse, error) {
+	var resp ListDPICategoriesResponse
+	if err := n.get(ctx, "/v1/dpi/categories"+req.ToQuery(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type ListDPIApplicationsRequest struct {
+	Offset int    `json:"offset"`
+	Limit  int    `json:"limit"`
+	Filter string `json:"filter"`
+}
+
+// ToQuery converts the request to URL query string.
+func (r *ListDPIApplicationsRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
+type ListDPIApplicationsResponse struct {
+	Offset     int              `json:"offset"`
+	Limit      int              `json:"limit"`
+	Count      int              `json:"count"`
+	TotalCount int              `json:"totalCount"`
+	Data       []DPIApplication `json:"data"`
+}
+
+// GET /v1/dpi/applications
+
+// ListDPIApplications retrieves the DPI applications.
+func (n *Network) ListDPIApplications(ctx context.Context, req *ListDPIApplicationsRequest) (*ListDPIApplicationsResponse, error) {
+	var resp ListDPIApplicationsResponse
+	if err := n.get(ctx, "/v1/dpi/applications"+req.ToQuery(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type ListCountriesRequest struct {
+	Offset int    `json:"offset"`
+	Limit  int    `json:"limit"`
+	Filter string `json:"filter"`
+}
+
+// ToQuery converts the request to URL query string.
+func (r *ListCountriesRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
+type ListCountriesResponse struct {
+	Offset     int       `json:"offset"`
+	Limit      int       `json:"limit"`
+	Count      int       `json:"count"`
+	TotalCount int       `json:"totalCount"`
+	Data       []Country `json:"data"`
+}
+
+// GET /v1/countries
+
+// ListCountries retrieves the countries usable in region filters.
+func (n *Network) ListCountries(ctx context.Context, req *ListCountriesRequest) (*ListCountriesResponse, error) {
+	var resp ListCountriesResponse
+	if err := n.get(ctx, "/v1/countries"+req.ToQuery(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,161 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// Resolver maps the names of a site's supporting resources to their IDs so that
+// requests can be written as WANInterfaceId: resolver.WAN("Primary").
+// Names are matched case-insensitively; when several resources share a name the first one listed wins,
+// as in ReferenceCatalog.
+//
+// The lookup methods return an empty ID for unknown names and remember them; check Err after building a request.
+// Err reports and forgets the misses, so a long-lived resolver can be reused for the next request.
+type Resolver struct {
+	wans           map[string]string
+	vpnTunnels     map[string]string
+	vpnServers     map[string]string
+	radiusProfiles map[string]string
+	deviceTags     map[string]string
+
+	mu         sync.Mutex
+	unresolved []string
+}
+
+// NewResolver loads the WAN interfaces, VPN tunnels, VPN servers, RADIUS profiles and device tags of a site.
+func (n *Network) NewResolver(ctx context.Context, siteID string) (*Resolver, error) {
+	if strings.TrimSpace(siteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	wans, err := listAll(func(offset int) ([]WANInterface, int, error) {
+		resp, err := n.ListWANInterfaces(ctx, &ListWANInterfacesRequest{SiteID: siteID, Offset: offset, Limit: pageLimit})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Data, resp.TotalCount, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list WAN interfaces: %w", err)
+	}
+
+	tunnels, err := listAll(func(offset int) ([]SiteToSiteVPNTunnel, int, error) {
+		resp, err := n.ListSiteToSiteVPNTunnels(ctx, &ListSiteToSiteVPNTunnelsRequest{SiteID: siteID, Offset: offset, Limit: pageLimit})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Data, resp.TotalCount, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list site-to-site VPN tunnels: %w", err)
+	}
+
+	servers, err := listAll(func(offset int) ([]VPNServer, int, error) {
+		resp, err := n.ListVPNServers(ctx, &ListVPNServersRequest{SiteID: siteID, Offset: offset, Limit: pageLimit})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Data, resp.TotalCount, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VPN servers: %w", err)
+	}
+
+	profiles, err := listAll(func(offset int) ([]RADIUSProfile, int, error) {
+		resp, err := n.ListRADIUSProfiles(ctx, &ListRADIUSProfilesRequest{SiteID: siteID, Offset: offset, Limit: pageLimit})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Data, resp.TotalCount, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list RADIUS profiles: %w", err)
+	}
+
+	tags, err := listAll(func(offset int) ([]DeviceTag, int, error) {
+		resp, err := n.ListDeviceTags(ctx, &ListDeviceTagsRequest{SiteID: siteID, Offset: offset, Limit: pageLimit})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Data, resp.TotalCount, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list device tags: %w", err)
+	}
+
+	return &Resolver{
+		wans:           nameIndex(wans, func(w WANInterface) (string, string) { return w.Name, w.ID }),
+		vpnTunnels:     nameIndex(tunnels, func(t SiteToSiteVPNTunnel) (string, string) { return t.Name, t.ID }),
+		vpnServers:     nameIndex(servers, func(s VPNServer) (string, string) { return s.Name, s.ID }),
+		radiusProfiles: nameIndex(profiles, func(p RADIUSProfile) (string, string) { return p.Name, p.ID }),
+		deviceTags:     nameIndex(tags, func(t DeviceTag) (string, string) { return t.Name, t.ID }),
+	}, nil
+}
+
+// WAN returns the ID of the WAN interface with the given name.
+func (r *Resolver) WAN(name string) string {
+	return r.resolve(r.wans, "WAN interface", name)
+}
+
+// VPNTunnel returns the ID of the site-to-site VPN tunnel with the given name.
+func (r *Resolver) VPNTunnel(name string) string {
+	return r.resolve(r.vpnTunnels, "site-to-site VPN tunnel", name)
+}
+
+// VPNServer returns the ID of the VPN server with the given name.
+func (r *Resolver) VPNServer(name string) string {
+	return r.resolve(r.vpnServers, "VPN server", name)
+}
+
+// RADIUSProfile returns the ID of the RADIUS profile with the given name.
+func (r *Resolver) RADIUSProfile(name string) string {
+	return r.resolve(r.radiusProfiles, "RADIUS profile", name)
+}
+
+// DeviceTag returns the ID of the device tag with the given name.
+func (r *Resolver) DeviceTag(name string) string {
+	return r.resolve(r.deviceTags, "device tag", name)
+}
+
+// Err returns an error wrapping errors.ErrResourceNotFound listing every name that could not be resolved
+// since the previous call to Err, or nil.
+func (r *Resolver) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.unresolved) == 0 {
+		return nil
+	}
+	err := fmt.Errorf("%w: %s", errors.ErrResourceNotFound, strings.Join(r.unresolved, ", "))
+	r.unresolved = nil
+	return err
+}
+
+func (r *Resolver) resolve(index map[string]string, kind, name string) string {
+	if id, ok := index[strings.ToLower(name)]; ok {
+		return id
+	}
+
+	r.mu.Lock()
+	r.unresolved = append(r.unresolved, fmt.Sprintf("%s %q", kind, name))
+	r.mu.Unlock()
+	return ""
+}
+
+// nameIndex maps lower-cased names to IDs, keeping the first ID of duplicate names.
+func nameIndex[T any](items []T, key func(T) (name, id string)) map[string]string {
+	index := make(map[string]string, len(items))
+	for _, item := range items {
+		name, id := key(item)
+		name = strings.ToLower(name)
+		if _, ok := index[name]; !ok {
+			index[name] = id
+		}
+	}
+	return index
+}
@@ -0,0 +1,86 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pkgerrors "github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// TestNetwork_NewResolver tests resolving supporting resource names to IDs.
+func TestNetwork_NewResolver(t *testing.T) {
+	t.Parallel()
+
+	responses := map[string]string{
+		"/integration/v1/sites/site-1/wans":                     `{"totalCount":3,"data":[{"id":"wan-1","name":"Primary"},{"id":"wan-2","name":"Backup"},{"id":"wan-3","name":"primary"}]}`,
+		"/integration/v1/sites/site-1/vpn/site-to-site-tunnels": `{"totalCount":1,"data":[{"id":"tun-1","name":"Office","type":"WIREGUARD"}]}`,
+		"/integration/v1/sites/site-1/vpn/servers":              `{"totalCount":0,"data":[]}`,
+		"/integration/v1/sites/site-1/radius/profiles":          `{"totalCount":1,"data":[{"id":"rad-1","name":"Default"}]}`,
+		"/integration/v1/sites/site-1/device-tags":              `{"totalCount":1,"data":[{"id":"tag-1","name":"Lobby APs","deviceIds":["d1"]}]}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			http.Redirect(w, r, "/manage", http.StatusFound)
+			return
+		}
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected path %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := newTestNetwork(t, server, Config{})
+	resolver, err := client.NewResolver(context.Background(), "site-1")
+	if err != nil {
+		t.Fatalf("NewResolver() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "wan", got: resolver.WAN("Primary"), want: "wan-1"},
+		{name: "wan case insensitive", got: resolver.WAN("backup"), want: "wan-2"},
+		{name: "vpn tunnel", got: resolver.VPNTunnel("Office"), want: "tun-1"},
+		{name: "radius profile", got: resolver.RADIUSProfile("Default"), want: "rad-1"},
+		{name: "device tag", got: resolver.DeviceTag("Lobby APs"), want: "tag-1"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+	if err := resolver.Err(); err != nil {
+		t.Fatalf("Err() = %v, want nil", err)
+	}
+
+	if id := resolver.VPNServer("Remote"); id != "" {
+		t.Errorf("VPNServer() = %q, want empty", id)
+	}
+	err = resolver.Err()
+	if !errors.Is(err, pkgerrors.ErrResourceNotFound) {
+		t.Fatalf("Err() = %v, want ErrResourceNotFound", err)
+	}
+	if !strings.Contains(err.Error(), `VPN server "Remote"`) {
+		t.Errorf("Err() = %v, want it to name the missing VPN server", err)
+	}
+
+	// Misses are reported once, so the next request built with the resolver starts clean.
+	if id := resolver.WAN("Primary"); id != "wan-1" {
+		t.Errorf("WAN() = %q, want %q", id, "wan-1")
+	}
+	if err := resolver.Err(); err != nil {
+		t.Errorf("Err() after a successful lookup = %v, want nil", err)
+	}
+}
@@ -1,11 +1,21 @@
 package network
 
+import "context"
+
 type ListLocalSitesRequest struct {
 	Offset int    `json:"offset"`
 	Limit  int    `json:"limit"`
 	Filter string `json:"filter"`
 }
 
+// ToQuery converts the request to URL query string.
+func (r *ListLocalSitesRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
 type ListLocalSitesResponse struct {
 	Offset     int            `json:"offset"`
 	Limit      int            `json:"limit"`
@@ -21,3 +31,12 @@ type SiteOverview struct {
 }
 
 // GET /v1/sites
+
+// ListSites retrieves the local sites managed by the controller.
+func (n *Network) ListSites(ctx context.Context, req *ListLocalSitesRequest) (*ListLocalSitesResponse, error) {
+	var resp ListLocalSitesResponse
+	if err := n.get(ctx, "/v1/sites"+req.ToQuery(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
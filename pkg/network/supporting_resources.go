@@ -0,0 +1,214 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+type ListWANInterfacesRequest struct {
+	SiteID string `json:"siteId"`
+
+	Offset int    `json:"offset"`
+	Limit  int    `json:"limit"`
+	Filter string `json:"filter"`
+}
+
+// ToQuery converts the request to URL query string.
+func (r *ListWANInterfacesRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
+type ListWANInterfacesResponse struct {
+	Offset     int            `json:"offset"`
+	Limit      int            `json:"limit"`
+	Count      int            `json:"count"`
+	TotalCount int            `json:"totalCount"`
+	Data       []WANInterface `json:"data"`
+}
+
+// GET /v1/sites/{siteId}/wans
+
+// ListWANInterfaces retrieves the WAN interfaces of a site.
+func (n *Network) ListWANInterfaces(ctx context.Context, req *ListWANInterfacesRequest) (*ListWANInterfacesResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/wans", req.SiteID) + req.ToQuery()
+
+	var resp ListWANInterfacesResponse
+	if err := n.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type ListSiteToSiteVPNTunnelsRequest struct {
+	SiteID string `json:"siteId"`
+
+	Offset int    `json:"offset"`
+	Limit  int    `json:"limit"`
+	Filter string `json:"filter"`
+}
+
+// ToQuery converts the request to URL query string.
+func (r *ListSiteToSiteVPNTunnelsRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
+type ListSiteToSiteVPNTunnelsResponse struct {
+	Offset     int                   `json:"offset"`
+	Limit      int                   `json:"limit"`
+	Count      int                   `json:"count"`
+	TotalCount int                   `json:"totalCount"`
+	Data       []SiteToSiteVPNTunnel `json:"data"`
+}
+
+// GET /v1/sites/{siteId}/vpn/site-to-site-tunnels
+
+// ListSiteToSiteVPNTunnels retrieves the site-to-site VPN tunnels of a site.
+func (n *Network) ListSiteToSiteVPNTunnels(ctx context.Context, req *ListSiteToSiteVPNTunnelsRequest) (*ListSiteToSiteVPNTunnelsResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/vpn/site-to-site-tunnels", req.SiteID) + req.ToQuery()
+
+	var resp ListSiteToSiteVPNTunnelsResponse
+	if err := n.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type ListVPNServersRequest struct {
+	SiteID string `json:"siteId"`
+
+	Offset int    `json:"offset"`
+	Limit  int    `json:"limit"`
+	Filter string `json:"filter"`
+}
+
+// ToQuery converts the request to URL query string.
+func (r *ListVPNServersRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
+type ListVPNServersResponse struct {
+	Offset     int         `json:"offset"`
+	Limit      int         `json:"limit"`
+	Count      int         `json:"count"`
+	TotalCount int         `json:"totalCount"`
+	Data       []VPNServer `json:"data"`
+}
+
+// GET /v1/sites/{siteId}/vpn/servers
+
+// ListVPNServers retrieves the VPN servers of a site.
+func (n *Network) ListVPNServers(ctx context.Context, req *ListVPNServersRequest) (*ListVPNServersResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/vpn/servers", req.SiteID) + req.ToQuery()
+
+	var resp ListVPNServersResponse
+	if err := n.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type ListRADIUSProfilesRequest struct {
+	SiteID string `json:"siteId"`
+
+	Offset int    `json:"offset"`
+	Limit  int    `json:"limit"`
+	Filter string `json:"filter"`
+}
+
+// ToQuery converts the request to URL query string.
+func (r *ListRADIUSProfilesRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
+type ListRADIUSProfilesResponse struct {
+	Offset     int             `json:"offset"`
+	Limit      int             `json:"limit"`
+	Count      int             `json:"count"`
+	TotalCount int             `json:"totalCount"`
+	Data       []RADIUSProfile `json:"data"`
+}
+
+// GET /v1/sites/{siteId}/radius/profiles
+
+// ListRADIUSProfiles retrieves the RADIUS profiles of a site.
+func (n *Network) ListRADIUSProfiles(ctx context.Context, req *ListRADIUSProfilesRequest) (*ListRADIUSProfilesResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/radius/profiles", req.SiteID) + req.ToQuery()
+
+	var resp ListRADIUSProfilesResponse
+	if err := n.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type ListDeviceTagsRequest struct {
+	SiteID string `json:"siteId"`
+
+	Offset int    `json:"offset"`
+	Limit  int    `json:"limit"`
+	Filter string `json:"filter"`
+}
+
+// ToQuery converts the request to URL query string.
+func (r *ListDeviceTagsRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
+type ListDeviceTagsResponse struct {
+	Offset     int         `json:"offset"`
+	Limit      int         `json:"limit"`
+	Count      int         `json:"count"`
+	TotalCount int         `json:"totalCount"`
+	Data       []DeviceTag `json:"data"`
+}
+
+// GET /v1/sites/{siteId}/device-tags
+
+// ListDeviceTags retrieves the device tags of a site.
+func (n *Network) ListDeviceTags(ctx context.Context, req *ListDeviceTagsRequest) (*ListDeviceTagsResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/device-tags", req.SiteID) + req.ToQuery()
+
+	var resp ListDeviceTagsResponse
+	if err := n.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,160 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+type ListTrafficMatchingListsRequest struct {
+	SiteID string `json:"siteId"`
+
+	Offset int    `json:"offset"`
+	Limit  int    `json:"limit"`
+	Filter string `json:"filter"`
+}
+
+// ToQuery converts the request to URL query string.
+func (r *ListTrafficMatchingListsRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
+type ListTrafficMatchingListsResponse struct {
+	Offset     int                   `json:"offset"`
+	Limit      int                   `json:"limit"`
+	Count      int                   `json:"count"`
+	TotalCount int                   `json:"totalCount"`
+	Data       []TrafficMatchingList `json:"data"`
+}
+
+// GET /v1/sites/{siteId}/traffic-matching-lists
+
+// ListTrafficMatchingLists retrieves the traffic matching lists of a site.
+func (n *Network) ListTrafficMatchingLists(ctx context.Context, req *ListTrafficMatchingListsRequest) (*ListTrafficMatchingListsResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/traffic-matching-lists", req.SiteID) + req.ToQuery()
+
+	var resp ListTrafficMatchingListsResponse
+	if err := n.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type TrafficMatchingListDetailsRequest struct {
+	TrafficMatchingListID string `json:"trafficMatchingListId"`
+	SiteID                string `json:"siteId"`
+}
+
+type TrafficMatchingListDetailsResponse struct {
+	TrafficMatchingList
+}
+
+// GET /v1/sites/{siteId}/traffic-matching-lists/{trafficMatchingListId}
+
+// GetTrafficMatchingList retrieves a single traffic matching list.
+func (n *Network) GetTrafficMatchingList(ctx context.Context, req *TrafficMatchingListDetailsRequest) (*TrafficMatchingListDetailsResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.TrafficMatchingListID) == "" {
+		return nil, errors.ErrEmptyTrafficMatchingListID
+	}
+
+	var resp TrafficMatchingListDetailsResponse
+	if err := n.get(ctx, fmt.Sprintf("/v1/sites/%s/traffic-matching-lists/%s", req.SiteID, req.TrafficMatchingListID), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type CreateTrafficMatchingListRequest struct {
+	SiteID string `json:"-"`
+
+	ListType TrafficMatchingListType   `json:"type"`
+	Name     string                    `json:"name"`
+	Items    []TrafficMatchingListItem `json:"items"`
+}
+
+type CreateTrafficMatchingListResponse struct {
+	TrafficMatchingList
+}
+
+// POST /v1/sites/{siteId}/traffic-matching-lists
+
+// CreateTrafficMatchingList creates a new traffic matching list.
+func (n *Network) CreateTrafficMatchingList(ctx context.Context, req *CreateTrafficMatchingListRequest) (*CreateTrafficMatchingListResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if err := validateTrafficMatchingList(req.ListType, req.Name, req.Items); err != nil {
+		return nil, err
+	}
+
+	var resp CreateTrafficMatchingListResponse
+	if err := n.post(ctx, fmt.Sprintf("/v1/sites/%s/traffic-matching-lists", req.SiteID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type UpdateTrafficMatchingListRequest struct {
+	TrafficMatchingListID string `json:"-"`
+	SiteID                string `json:"-"`
+
+	ListType TrafficMatchingListType   `json:"type"`
+	Name     string                    `json:"name"`
+	Items    []TrafficMatchingListItem `json:"items"`
+}
+
+type UpdateTrafficMatchingListResponse struct {
+	TrafficMatchingList
+}
+
+// PUT /v1/sites/{siteId}/traffic-matching-lists/{trafficMatchingListId}
+
+// UpdateTrafficMatchingList replaces the name and items of a traffic matching list.
+func (n *Network) UpdateTrafficMatchingList(ctx context.Context, req *UpdateTrafficMatchingListRequest) (*UpdateTrafficMatchingListResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.TrafficMatchingListID) == "" {
+		return nil, errors.ErrEmptyTrafficMatchingListID
+	}
+	if err := validateTrafficMatchingList(req.ListType, req.Name, req.Items); err != nil {
+		return nil, err
+	}
+
+	var resp UpdateTrafficMatchingListResponse
+	if err := n.put(ctx, fmt.Sprintf("/v1/sites/%s/traffic-matching-lists/%s", req.SiteID, req.TrafficMatchingListID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type DeleteTrafficMatchingListRequest struct {
+	TrafficMatchingListID string `json:"trafficMatchingListId"`
+	SiteID                string `json:"siteId"`
+}
+
+// DELETE /v1/sites/{siteId}/traffic-matching-lists/{trafficMatchingListId}
+
+// DeleteTrafficMatchingList deletes a traffic matching list. Lists still referenced by firewall policies cannot be deleted.
+func (n *Network) DeleteTrafficMatchingList(ctx context.Context, req *DeleteTrafficMatchingListRequest) error {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.TrafficMatchingListID) == "" {
+		return errors.ErrEmptyTrafficMatchingListID
+	}
+
+	return n.delete(ctx, fmt.Sprintf("/v1/sites/%s/traffic-matching-lists/%s", req.SiteID, req.TrafficMatchingListID), nil)
+}
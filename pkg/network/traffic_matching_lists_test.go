@@ -0,0 +1,118 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	pkgerrors "github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// TestIpAddressRange_Validate tests IP address range validation.
+func TestIpAddressRange_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		r       IpAddressRange
+		wantErr bool
+	}{
+		{name: "ipv4", r: IpAddressRange{Start: "192.168.1.10", Stop: "192.168.1.20"}},
+		{name: "single address", r: IpAddressRange{Start: "10.0.0.1", Stop: "10.0.0.1"}},
+		{name: "ipv6", r: IpAddressRange{Start: "2001:db8::1", Stop: "2001:db8::ff"}},
+		{name: "start after stop", r: IpAddressRange{Start: "192.168.1.20", Stop: "192.168.1.10"}, wantErr: true},
+		{name: "mixed families", r: IpAddressRange{Start: "192.168.1.10", Stop: "2001:db8::1"}, wantErr: true},
+		{name: "invalid start", r: IpAddressRange{Start: "192.168.1", Stop: "192.168.1.10"}, wantErr: true},
+		{name: "empty stop", r: IpAddressRange{Start: "192.168.1.10"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.r.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !pkgerrors.IsValidationError(err) {
+				t.Errorf("expected validation error, got %T", err)
+			}
+		})
+	}
+}
+
+// TestNetwork_CreateTrafficMatchingList tests that invalid lists are rejected before the request is sent.
+func TestNetwork_CreateTrafficMatchingList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		listType TrafficMatchingListType
+		items    []TrafficMatchingListItem
+		wantErr  bool
+	}{
+		{name: "ports", listType: TrafficMatchingListTypePorts, items: []TrafficMatchingListItem{PortItem(443), PortRangeItem(8000, 8080)}},
+		{name: "ipv4", listType: TrafficMatchingListTypeIPv4Addresses, items: []TrafficMatchingListItem{
+			IPAddressItem("10.0.0.1"), SubnetItem("10.0.1.0/24"), IPAddressRangeItem(IpAddressRange{Start: "10.0.2.1", Stop: "10.0.2.50"}),
+		}},
+		{name: "ipv6", listType: TrafficMatchingListTypeIPv6Addresses, items: []TrafficMatchingListItem{IPAddressItem("2001:db8::1"), SubnetItem("2001:db8:1::/64")}},
+		{name: "port out of range", listType: TrafficMatchingListTypePorts, items: []TrafficMatchingListItem{PortItem(70000)}, wantErr: true},
+		{name: "reversed port range", listType: TrafficMatchingListTypePorts, items: []TrafficMatchingListItem{PortRangeItem(9000, 8000)}, wantErr: true},
+		{name: "address in port list", listType: TrafficMatchingListTypePorts, items: []TrafficMatchingListItem{IPAddressItem("10.0.0.1")}, wantErr: true},
+		{name: "ipv6 in ipv4 list", listType: TrafficMatchingListTypeIPv4Addresses, items: []TrafficMatchingListItem{SubnetItem("2001:db8::/64")}, wantErr: true},
+		{name: "reversed address range", listType: TrafficMatchingListTypeIPv4Addresses, items: []TrafficMatchingListItem{
+			IPAddressRangeItem(IpAddressRange{Start: "10.0.2.50", Stop: "10.0.2.1"}),
+		}, wantErr: true},
+		{name: "mixed address range", listType: TrafficMatchingListTypeIPv6Addresses, items: []TrafficMatchingListItem{
+			IPAddressRangeItem(IpAddressRange{Start: "2001:db8::1", Stop: "10.0.2.1"}),
+		}, wantErr: true},
+		{name: "empty", listType: TrafficMatchingListTypePorts, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/" {
+					http.Redirect(w, r, "/manage", http.StatusFound)
+					return
+				}
+				calls.Add(1)
+				if r.URL.Path != "/integration/v1/sites/site-1/traffic-matching-lists" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"id":"list-1","type":"PORTS","name":"web"}`))
+			}))
+			defer server.Close()
+
+			client := newTestNetwork(t, server, Config{})
+			resp, err := client.CreateTrafficMatchingList(context.Background(), &CreateTrafficMatchingListRequest{
+				SiteID:   "site-1",
+				ListType: tt.listType,
+				Name:     "web",
+				Items:    tt.items,
+			})
+
+			if tt.wantErr {
+				if !pkgerrors.IsValidationError(err) {
+					t.Fatalf("expected validation error, got %v", err)
+				}
+				if calls.Load() != 0 {
+					t.Errorf("expected no request, got %d", calls.Load())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp.ID != "list-1" {
+				t.Errorf("ID = %q, want %q", resp.ID, "list-1")
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package network
+
+import (
+	"fmt"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+type ACLRuleType string
+
+const (
+	ACLRuleTypeIPv4 ACLRuleType = "IPV4"
+	ACLRuleTypeMAC  ACLRuleType = "MAC"
+)
+
+type ACLRuleAction string
+
+const (
+	ACLRuleActionAllow ACLRuleAction = "ALLOW"
+	ACLRuleActionBlock ACLRuleAction = "BLOCK"
+)
+
+// ACLRule is a layer 2 (MAC) or layer 3 (IPv4) access control rule enforced by switches.
+type ACLRule struct {
+	ACLRuleType           ACLRuleType           `json:"type"`
+	ID                    string                `json:"id"`
+	Name                  string                `json:"name"`
+	Description           string                `json:"description"`
+	Enabled               bool                  `json:"enabled"`
+	Action                ACLRuleAction         `json:"action"`
+	Index                 int                   `json:"index"`
+	Metadata              NetworkDetailMetadata `json:"metadata"`
+	EnforcingDeviceFilter *ACLDeviceFilter      `json:"enforcingDeviceFilter,omitempty"`
+	SourceFilter          *ACLRuleFilter        `json:"sourceFilter,omitempty"`
+	DestinationFilter     *ACLRuleFilter        `json:"destinationFilter,omitempty"`
+	// ProtocolFilter limits IPv4 rules to the given protocols (e.g. "TCP", "UDP"); empty matches all.
+	ProtocolFilter []string `json:"protocolFilter,omitempty"`
+}
+
+// ACLDeviceFilter limits the switches that enforce a rule. All switches enforce it if nil.
+type ACLDeviceFilter struct {
+	DeviceIDs []string `json:"deviceIds"`
+}
+
+type ACLRuleFilterType string
+
+const (
+	ACLRuleFilterTypeIPAddresses  ACLRuleFilterType = "IP_ADDRESSES_OR_SUBNETS"
+	ACLRuleFilterTypeNetworks     ACLRuleFilterType = "NETWORKS"
+	ACLRuleFilterTypeMACAddresses ACLRuleFilterType = "MAC_ADDRESSES"
+)
+
+// ACLRuleFilter matches the source or destination of an ACL rule.
+// Only the field matching ACLRuleFilterType is set.
+type ACLRuleFilter struct {
+	ACLRuleFilterType    ACLRuleFilterType `json:"type"`
+	IPAddressesOrSubnets []string          `json:"ipAddressesOrSubnets,omitempty"`
+	NetworkIDs           []string          `json:"networkIds,omitempty"`
+	MACAddresses         []string          `json:"macAddresses,omitempty"`
+	// PortFilter limits IPv4 rules to the given ports and port ranges (e.g. "22", "8000-8080").
+	PortFilter []string `json:"portFilter,omitempty"`
+}
+
+// validate checks that the filter is compatible with the rule type.
+func (f *ACLRuleFilter) validate(field string, ruleType ACLRuleType) error {
+	if f == nil {
+		return nil
+	}
+
+	switch f.ACLRuleFilterType {
+	case ACLRuleFilterTypeIPAddresses, ACLRuleFilterTypeNetworks:
+		if ruleType != ACLRuleTypeIPv4 {
+			return errors.NewValidationError(field, fmt.Sprintf("%s filter cannot be used in %s rules", f.ACLRuleFilterType, ruleType))
+		}
+	case ACLRuleFilterTypeMACAddresses:
+		if ruleType != ACLRuleTypeMAC {
+			return errors.NewValidationError(field, fmt.Sprintf("%s filter cannot be used in %s rules", f.ACLRuleFilterType, ruleType))
+		}
+		if len(f.PortFilter) > 0 {
+			return errors.NewValidationError(field+".portFilter", "is only supported by IPV4 rules")
+		}
+	default:
+		return errors.NewValidationError(field+".type", fmt.Sprintf("unknown filter type %q", f.ACLRuleFilterType))
+	}
+	return nil
+}
+
+// ACLRuleOrdering is the evaluation order of the user-defined ACL rules.
+type ACLRuleOrdering struct {
+	OrderedACLRuleIDs []string `json:"orderedAclRuleIds"`
+}
@@ -37,6 +37,16 @@ type AdoptDeviceInterface struct {
 	Radios []AdoptDeviceInterfaceRadios `json:"radios"`
 }
 
+// port returns the port with the given index.
+func (i AdoptDeviceInterface) port(idx int) (AdoptDeviceInterfacePort, bool) {
+	for _, p := range i.Ports {
+		if p.Idx == idx {
+			return p, true
+		}
+	}
+	return AdoptDeviceInterfacePort{}, false
+}
+
 type AdoptDeviceInterfacePort struct {
 	Idx          int                         `json:"idx"`
 	State        string                      `json:"state"`
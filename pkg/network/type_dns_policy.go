@@ -0,0 +1,44 @@
+package network
+
+type DNSPolicyType string
+
+const (
+	DNSPolicyTypeA             DNSPolicyType = "A_RECORD"
+	DNSPolicyTypeAAAA          DNSPolicyType = "AAAA_RECORD"
+	DNSPolicyTypeCNAME         DNSPolicyType = "CNAME_RECORD"
+	DNSPolicyTypeMX            DNSPolicyType = "MX_RECORD"
+	DNSPolicyTypeTXT           DNSPolicyType = "TXT_RECORD"
+	DNSPolicyTypeSRV           DNSPolicyType = "SRV_RECORD"
+	DNSPolicyTypeForwardDomain DNSPolicyType = "FORWARD_DOMAIN"
+)
+
+// DNSPolicy is a local DNS record or forwarded domain served by the gateway.
+// Only the fields relevant to DNSPolicyType are set.
+type DNSPolicy struct {
+	DNSPolicyType DNSPolicyType          `json:"type"`
+	ID            string                 `json:"id,omitempty"`
+	Enabled       bool                   `json:"enabled"`
+	Domain        string                 `json:"domain"`
+	Metadata      *NetworkDetailMetadata `json:"metadata,omitempty"`
+	TTLSeconds    int                    `json:"ttlSeconds,omitempty"`
+
+	// IPv4Address is set for A records.
+	IPv4Address string `json:"ipv4Address,omitempty"`
+	// IPv6Address is set for AAAA records.
+	IPv6Address string `json:"ipv6Address,omitempty"`
+	// TargetDomain is set for CNAME records.
+	TargetDomain string `json:"targetDomain,omitempty"`
+	// MailServerDomain and Priority are set for MX records.
+	MailServerDomain string `json:"mailServerDomain,omitempty"`
+	Priority         int    `json:"priority,omitempty"`
+	// Text is set for TXT records.
+	Text string `json:"text,omitempty"`
+	// ServerDomain, Service, Protocol, Port, Priority and Weight are set for SRV records.
+	ServerDomain string `json:"serverDomain,omitempty"`
+	Service      string `json:"service,omitempty"`
+	Protocol     string `json:"protocol,omitempty"`
+	Port         int    `json:"port,omitempty"`
+	Weight       int    `json:"weight,omitempty"`
+	// IPAddress is the DNS server queries are forwarded to, set for forwarded domains.
+	IPAddress string `json:"ipAddress,omitempty"`
+}
@@ -0,0 +1,80 @@
+package network
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// EventType is the meta.message of a controller websocket message.
+type EventType string
+
+const (
+	// EventTypeEvents carries controller events such as client connects and device disconnects.
+	EventTypeEvents EventType = "events"
+	// EventTypeClientSync carries updated client statistics.
+	EventTypeClientSync EventType = "sta:sync"
+	// EventTypeDeviceSync carries updated device statistics.
+	EventTypeDeviceSync EventType = "device:sync"
+	// EventTypeDeviceUpdate carries partial device updates.
+	EventTypeDeviceUpdate EventType = "device:update"
+)
+
+// Event is a single entry of a controller websocket message.
+// The typed field matching Type is set when the entry could be decoded; Raw always holds the original JSON.
+type Event struct {
+	Type EventType
+	Raw  json.RawMessage
+
+	// Controller is set for EventTypeEvents.
+	Controller *ControllerEvent
+	// Client is set for EventTypeClientSync.
+	Client *LegacyClient
+	// Device is set for EventTypeDeviceSync and EventTypeDeviceUpdate.
+	Device *LegacyDevice
+}
+
+type EventKey string
+
+const (
+	EventKeyWirelessClientConnected     EventKey = "EVT_WU_Connected"
+	EventKeyWirelessClientDisconnected  EventKey = "EVT_WU_Disconnected"
+	EventKeyWirelessClientRoam          EventKey = "EVT_WU_Roam"
+	EventKeyWirelessClientRoamRadio     EventKey = "EVT_WU_RoamRadio"
+	EventKeyWirelessGuestConnected      EventKey = "EVT_WG_Connected"
+	EventKeyWirelessGuestDisconnected   EventKey = "EVT_WG_Disconnected"
+	EventKeyWiredClientConnected        EventKey = "EVT_LU_Connected"
+	EventKeyWiredClientDisconnected     EventKey = "EVT_LU_Disconnected"
+	EventKeyAccessPointLostContact      EventKey = "EVT_AP_Lost_Contact"
+	EventKeyAccessPointRestartedUnknown EventKey = "EVT_AP_RestartedUnknown"
+	EventKeySwitchLostContact           EventKey = "EVT_SW_Lost_Contact"
+	EventKeyGatewayLostContact          EventKey = "EVT_GW_Lost_Contact"
+	EventKeyGatewayWANTransition        EventKey = "EVT_GW_WANTransition"
+)
+
+// ControllerEvent is an entry of the controller event log.
+// Only the fields relevant to Key are set; MAC addresses identify clients (User, Guest) and devices (AP, Switch, Gateway).
+type ControllerEvent struct {
+	ID        string   `json:"_id"`
+	Key       EventKey `json:"key"`
+	SiteID    string   `json:"site_id"`
+	Subsystem string   `json:"subsystem"`
+	Message   string   `json:"msg"`
+	// Time is the event time in Unix milliseconds.
+	Time     int64  `json:"time"`
+	User     string `json:"user,omitempty"`
+	Guest    string `json:"guest,omitempty"`
+	Hostname string `json:"hostname,omitempty"`
+	SSID     string `json:"ssid,omitempty"`
+	AP       string `json:"ap,omitempty"`
+	APName   string `json:"ap_name,omitempty"`
+	APFrom   string `json:"ap_from,omitempty"`
+	APTo     string `json:"ap_to,omitempty"`
+	Switch   string `json:"sw,omitempty"`
+	Gateway  string `json:"gw,omitempty"`
+	Channel  string `json:"channel,omitempty"`
+}
+
+// Timestamp returns the event time.
+func (e ControllerEvent) Timestamp() time.Time {
+	return time.UnixMilli(e.Time)
+}
@@ -0,0 +1,148 @@
+package network
+
+// FirewallZone groups networks that share the same firewall policies.
+type FirewallZone struct {
+	ID         string                `json:"id"`
+	Name       string                `json:"name"`
+	NetworkIDs []string              `json:"networkIds"`
+	Metadata   NetworkDetailMetadata `json:"metadata"`
+}
+
+type FirewallPolicyActionType string
+
+const (
+	FirewallPolicyActionAllow  FirewallPolicyActionType = "ALLOW"
+	FirewallPolicyActionBlock  FirewallPolicyActionType = "BLOCK"
+	FirewallPolicyActionReject FirewallPolicyActionType = "REJECT"
+)
+
+type FirewallPolicyAction struct {
+	FirewallPolicyActionType FirewallPolicyActionType `json:"type"`
+	AllowReturnTraffic       bool                     `json:"allowReturnTraffic,omitempty"`
+}
+
+type FirewallIPVersion string
+
+const (
+	FirewallIPVersionIPv4        FirewallIPVersion = "IPV4"
+	FirewallIPVersionIPv6        FirewallIPVersion = "IPV6"
+	FirewallIPVersionIPv4AndIPv6 FirewallIPVersion = "IPV4_AND_IPV6"
+)
+
+type FirewallIPProtocolScope struct {
+	IPVersion FirewallIPVersion `json:"ipVersion"`
+	// Protocol is the IP protocol matched by the policy (e.g. "TCP", "UDP"); empty matches all.
+	Protocol string `json:"protocol,omitempty"`
+}
+
+type FirewallConnectionState string
+
+const (
+	FirewallConnectionStateNew         FirewallConnectionState = "NEW"
+	FirewallConnectionStateEstablished FirewallConnectionState = "ESTABLISHED"
+	FirewallConnectionStateRelated     FirewallConnectionState = "RELATED"
+	FirewallConnectionStateInvalid     FirewallConnectionState = "INVALID"
+)
+
+// FirewallPolicyEndpoint matches the source or destination of a firewall policy.
+type FirewallPolicyEndpoint struct {
+	ZoneID        string                 `json:"zoneId"`
+	TrafficFilter *FirewallTrafficFilter `json:"trafficFilter,omitempty"`
+}
+
+type FirewallTrafficFilterType string
+
+const (
+	FirewallTrafficFilterTypeNetwork     FirewallTrafficFilterType = "NETWORK"
+	FirewallTrafficFilterTypeIPAddress   FirewallTrafficFilterType = "IP_ADDRESS"
+	FirewallTrafficFilterTypeMACAddress  FirewallTrafficFilterType = "MAC_ADDRESS"
+	FirewallTrafficFilterTypePort        FirewallTrafficFilterType = "PORT"
+	FirewallTrafficFilterTypeDomain      FirewallTrafficFilterType = "DOMAIN"
+	FirewallTrafficFilterTypeApplication FirewallTrafficFilterType = "APPLICATION"
+	FirewallTrafficFilterTypeRegion      FirewallTrafficFilterType = "REGION"
+)
+
+// FirewallTrafficFilter narrows the traffic matched within a zone.
+// Only the filter matching FirewallTrafficFilterType is set.
+type FirewallTrafficFilter struct {
+	FirewallTrafficFilterType FirewallTrafficFilterType  `json:"type"`
+	NetworkFilter             *FirewallNetworkFilter     `json:"networkFilter,omitempty"`
+	IPAddressFilter           *FirewallIPAddressFilter   `json:"ipAddressFilter,omitempty"`
+	MACAddressFilter          *FirewallMACAddressFilter  `json:"macAddressFilter,omitempty"`
+	PortFilter                *FirewallPortFilter        `json:"portFilter,omitempty"`
+	DomainFilter              *FirewallDomainFilter      `json:"domainFilter,omitempty"`
+	ApplicationFilter         *FirewallApplicationFilter `json:"applicationFilter,omitempty"`
+	RegionFilter              *FirewallRegionFilter      `json:"regionFilter,omitempty"`
+}
+
+type FirewallNetworkFilter struct {
+	NetworkIDs    []string `json:"networkIds"`
+	MatchOpposite bool     `json:"matchOpposite"`
+}
+
+type FirewallMatchType string
+
+const (
+	FirewallMatchTypeValues              FirewallMatchType = "VALUES"
+	FirewallMatchTypeTrafficMatchingList FirewallMatchType = "TRAFFIC_MATCHING_LIST"
+)
+
+// FirewallIPAddressFilter matches IP addresses, subnets and ranges, either inline or through a traffic matching list.
+type FirewallIPAddressFilter struct {
+	FirewallMatchType     FirewallMatchType `json:"type"`
+	Items                 []string          `json:"items,omitempty"`
+	TrafficMatchingListID string            `json:"trafficMatchingListId,omitempty"`
+	MatchOpposite         bool              `json:"matchOpposite"`
+}
+
+type FirewallMACAddressFilter struct {
+	MACAddresses []string `json:"macAddresses"`
+}
+
+// FirewallPortFilter matches ports and port ranges (e.g. "443", "8000-8080"), either inline or through a traffic matching list.
+type FirewallPortFilter struct {
+	FirewallMatchType     FirewallMatchType `json:"type"`
+	Items                 []string          `json:"items,omitempty"`
+	TrafficMatchingListID string            `json:"trafficMatchingListId,omitempty"`
+	MatchOpposite         bool              `json:"matchOpposite"`
+}
+
+type FirewallDomainFilter struct {
+	Domains []string `json:"domains"`
+}
+
+type FirewallApplicationFilter struct {
+	ApplicationIDs         []int `json:"applicationIds,omitempty"`
+	ApplicationCategoryIDs []int `json:"applicationCategoryIds,omitempty"`
+}
+
+type FirewallRegionFilter struct {
+	// Regions are ISO 3166-1 alpha-2 country codes.
+	Regions []string `json:"regions"`
+}
+
+type FirewallPolicy struct {
+	ID                    string                    `json:"id"`
+	Name                  string                    `json:"name"`
+	Description           string                    `json:"description"`
+	Enabled               bool                      `json:"enabled"`
+	Metadata              NetworkDetailMetadata     `json:"metadata"`
+	Action                FirewallPolicyAction      `json:"action"`
+	Source                FirewallPolicyEndpoint    `json:"source"`
+	Destination           FirewallPolicyEndpoint    `json:"destination"`
+	IPProtocolScope       FirewallIPProtocolScope   `json:"ipProtocolScope"`
+	ConnectionStateFilter []FirewallConnectionState `json:"connectionStateFilter,omitempty"`
+	LoggingEnabled        bool                      `json:"loggingEnabled"`
+	Index                 int                       `json:"index"`
+}
+
+// FirewallPolicyOrdering is the evaluation order of the user-defined policies between two zones,
+// relative to the system-defined policies.
+type FirewallPolicyOrdering struct {
+	OrderedFirewallPolicyIDs FirewallOrderedPolicyIDs `json:"orderedFirewallPolicyIds"`
+}
+
+type FirewallOrderedPolicyIDs struct {
+	BeforeSystemDefined []string `json:"beforeSystemDefined"`
+	AfterSystemDefined  []string `json:"afterSystemDefined"`
+}
@@ -0,0 +1,125 @@
+package network
+
+// LegacySite is a site as returned by the classic API.
+type LegacySite struct {
+	ID   string `json:"_id"`
+	Name string `json:"name"`
+	Desc string `json:"desc"`
+	Role string `json:"role"`
+}
+
+// SiteHealth is the health of one subsystem (wan, www, lan, wlan, vpn) of a site.
+type SiteHealth struct {
+	Subsystem       string  `json:"subsystem"`
+	Status          string  `json:"status"`
+	NumUser         int     `json:"num_user"`
+	NumGuest        int     `json:"num_guest"`
+	NumIoT          int     `json:"num_iot"`
+	NumAP           int     `json:"num_ap"`
+	NumSW           int     `json:"num_sw"`
+	NumGW           int     `json:"num_gw"`
+	NumAdopted      int     `json:"num_adopted"`
+	NumDisabled     int     `json:"num_disabled"`
+	NumDisconnected int     `json:"num_disconnected"`
+	NumPending      int     `json:"num_pending"`
+	TxBytesRate     float64 `json:"tx_bytes-r"`
+	RxBytesRate     float64 `json:"rx_bytes-r"`
+	WANIP           string  `json:"wan_ip,omitempty"`
+	ISPName         string  `json:"isp_name,omitempty"`
+	Latency         int     `json:"latency,omitempty"`
+}
+
+// LegacyDevice is an adopted or pending device as returned by the classic stat/device route.
+type LegacyDevice struct {
+	ID       string `json:"_id"`
+	MAC      string `json:"mac"`
+	IP       string `json:"ip"`
+	Name     string `json:"name"`
+	Model    string `json:"model"`
+	Type     string `json:"type"`
+	Serial   string `json:"serial"`
+	Version  string `json:"version"`
+	Adopted  bool   `json:"adopted"`
+	State    int    `json:"state"`
+	Uptime   int64  `json:"uptime"`
+	LastSeen int64  `json:"last_seen"`
+	NumSta   int    `json:"num_sta"`
+}
+
+// LegacyClient is a client as returned by the classic stat/sta and stat/alluser routes.
+// Timestamps are Unix seconds.
+type LegacyClient struct {
+	ID          string `json:"_id"`
+	MAC         string `json:"mac"`
+	IP          string `json:"ip,omitempty"`
+	LastIP      string `json:"last_ip,omitempty"`
+	Hostname    string `json:"hostname,omitempty"`
+	Name        string `json:"name,omitempty"`
+	OUI         string `json:"oui,omitempty"`
+	IsWired     bool   `json:"is_wired"`
+	IsGuest     bool   `json:"is_guest"`
+	Blocked     bool   `json:"blocked"`
+	UseFixedIP  bool   `json:"use_fixedip"`
+	FixedIP     string `json:"fixed_ip,omitempty"`
+	NetworkID   string `json:"network_id,omitempty"`
+	UserGroupID string `json:"usergroup_id,omitempty"`
+	ESSID       string `json:"essid,omitempty"`
+	APMAC       string `json:"ap_mac,omitempty"`
+	SwitchMAC   string `json:"sw_mac,omitempty"`
+	Signal      int    `json:"signal,omitempty"`
+	Uptime      int64  `json:"uptime,omitempty"`
+	TxBytes     int64  `json:"tx_bytes,omitempty"`
+	RxBytes     int64  `json:"rx_bytes,omitempty"`
+	FirstSeen   int64  `json:"first_seen,omitempty"`
+	LastSeen    int64  `json:"last_seen,omitempty"`
+}
+
+// LegacyWLANConf is a WLAN configuration as returned by the classic rest/wlanconf route.
+type LegacyWLANConf struct {
+	ID            string `json:"_id"`
+	SiteID        string `json:"site_id"`
+	Name          string `json:"name"`
+	Enabled       bool   `json:"enabled"`
+	Security      string `json:"security"`
+	WPAMode       string `json:"wpa_mode,omitempty"`
+	XPassphrase   string `json:"x_passphrase,omitempty"`
+	NetworkConfID string `json:"networkconf_id,omitempty"`
+	IsGuest       bool   `json:"is_guest"`
+	HideSSID      bool   `json:"hide_ssid"`
+}
+
+// LegacyNetworkConf is a network configuration as returned by the classic rest/networkconf route.
+type LegacyNetworkConf struct {
+	ID           string `json:"_id"`
+	SiteID       string `json:"site_id"`
+	Name         string `json:"name"`
+	Purpose      string `json:"purpose"`
+	Enabled      bool   `json:"enabled"`
+	NetworkGroup string `json:"networkgroup,omitempty"`
+	VLANEnabled  bool   `json:"vlan_enabled"`
+	VLAN         int    `json:"vlan,omitempty"`
+	IPSubnet     string `json:"ip_subnet,omitempty"`
+	DomainName   string `json:"domain_name,omitempty"`
+	DHCPDEnabled bool   `json:"dhcpd_enabled"`
+	DHCPDStart   string `json:"dhcpd_start,omitempty"`
+	DHCPDStop    string `json:"dhcpd_stop,omitempty"`
+}
+
+type DeviceCommand string
+
+const (
+	DeviceCommandRestart     DeviceCommand = "restart"
+	DeviceCommandSetLocate   DeviceCommand = "set-locate"
+	DeviceCommandUnsetLocate DeviceCommand = "unset-locate"
+	DeviceCommandPowerCycle  DeviceCommand = "power-cycle"
+	DeviceCommandProvision   DeviceCommand = "force-provision"
+	DeviceCommandUpgrade     DeviceCommand = "upgrade"
+)
+
+// DeviceCommandRequest is a command sent to the classic cmd/devmgr route.
+type DeviceCommandRequest struct {
+	Command DeviceCommand `json:"cmd"`
+	MAC     string        `json:"mac"`
+	// PortIdx is the port to power cycle, only used by power-cycle.
+	PortIdx int `json:"port_idx,omitempty"`
+}
@@ -1,5 +1,12 @@
 package network
 
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
 type NetworkManagementType string
 
 const (
@@ -80,6 +87,27 @@ type IpAddressRange struct {
 	Stop  string `json:"stop"`
 }
 
+// Validate checks that Start and Stop are IP addresses of the same family and that Start is not after Stop.
+func (r IpAddressRange) Validate() error {
+	start, err := netip.ParseAddr(r.Start)
+	if err != nil {
+		return errors.NewValidationError("start", fmt.Sprintf("invalid IP address %q", r.Start))
+	}
+	stop, err := netip.ParseAddr(r.Stop)
+	if err != nil {
+		return errors.NewValidationError("stop", fmt.Sprintf("invalid IP address %q", r.Stop))
+	}
+
+	start, stop = start.Unmap(), stop.Unmap()
+	if start.Is4() != stop.Is4() {
+		return errors.NewValidationError("", fmt.Sprintf("start %s and stop %s are different IP families", r.Start, r.Stop))
+	}
+	if start.Compare(stop) > 0 {
+		return errors.NewValidationError("", fmt.Sprintf("start %s is after stop %s", r.Start, r.Stop))
+	}
+	return nil
+}
+
 type PXEConfiguration struct {
 	ServerIpAddress string `json:"serverIpAddress"`
 	Filename        string `json:"filename"`
@@ -0,0 +1,19 @@
+package network
+
+// DPICategory is a deep packet inspection category, referenced by ID from application filters.
+type DPICategory struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// DPIApplication is an application recognized by deep packet inspection, referenced by ID from application filters.
+type DPIApplication struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// Country is a region usable in region filters, identified by its ISO 3166-1 alpha-2 code.
+type Country struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
@@ -0,0 +1,55 @@
+package network
+
+// WANInterface is a WAN uplink of the site gateway, referenced by ID from NAT and IPv6 prefix delegation settings.
+type WANInterface struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type VPNTunnelType string
+
+const (
+	VPNTunnelTypeIPsec     VPNTunnelType = "IPSEC"
+	VPNTunnelTypeOpenVPN   VPNTunnelType = "OPENVPN"
+	VPNTunnelTypeWireGuard VPNTunnelType = "WIREGUARD"
+)
+
+// SiteToSiteVPNTunnel is a VPN tunnel connecting the site to a remote network.
+type SiteToSiteVPNTunnel struct {
+	TunnelType VPNTunnelType         `json:"type"`
+	ID         string                `json:"id"`
+	Name       string                `json:"name"`
+	Metadata   NetworkDetailMetadata `json:"metadata"`
+}
+
+type VPNServerType string
+
+const (
+	VPNServerTypeL2TP      VPNServerType = "L2TP"
+	VPNServerTypeOpenVPN   VPNServerType = "OPENVPN"
+	VPNServerTypeWireGuard VPNServerType = "WIREGUARD"
+)
+
+// VPNServer is a remote access VPN server hosted by the site gateway.
+type VPNServer struct {
+	ServerType VPNServerType         `json:"type"`
+	ID         string                `json:"id"`
+	Name       string                `json:"name"`
+	Enabled    bool                  `json:"enabled"`
+	Metadata   NetworkDetailMetadata `json:"metadata"`
+}
+
+// RADIUSProfile is a RADIUS server configuration used by WPA Enterprise WLANs and 802.1X port authentication.
+type RADIUSProfile struct {
+	ID       string                `json:"id"`
+	Name     string                `json:"name"`
+	Metadata NetworkDetailMetadata `json:"metadata"`
+}
+
+// DeviceTag groups devices, e.g. to limit the access points broadcasting a WLAN.
+type DeviceTag struct {
+	ID        string                `json:"id"`
+	Name      string                `json:"name"`
+	DeviceIDs []string              `json:"deviceIds"`
+	Metadata  NetworkDetailMetadata `json:"metadata"`
+}
@@ -0,0 +1,179 @@
+package network
+
+import (
+	stderrors "errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+type TrafficMatchingListType string
+
+const (
+	TrafficMatchingListTypePorts         TrafficMatchingListType = "PORTS"
+	TrafficMatchingListTypeIPv4Addresses TrafficMatchingListType = "IPV4_ADDRESSES"
+	TrafficMatchingListTypeIPv6Addresses TrafficMatchingListType = "IPV6_ADDRESSES"
+)
+
+type TrafficMatchingListItemType string
+
+const (
+	TrafficMatchingListItemTypePortNumber      TrafficMatchingListItemType = "PORT_NUMBER"
+	TrafficMatchingListItemTypePortNumberRange TrafficMatchingListItemType = "PORT_NUMBER_RANGE"
+	TrafficMatchingListItemTypeIPAddress       TrafficMatchingListItemType = "IP_ADDRESS"
+	TrafficMatchingListItemTypeSubnet          TrafficMatchingListItemType = "SUBNET"
+	TrafficMatchingListItemTypeIPAddressRange  TrafficMatchingListItemType = "IP_ADDRESS_RANGE"
+)
+
+// TrafficMatchingList is a reusable list of ports or IP addresses referenced by firewall policies and ACL rules.
+type TrafficMatchingList struct {
+	ListType TrafficMatchingListType   `json:"type"`
+	ID       string                    `json:"id"`
+	Name     string                    `json:"name"`
+	Items    []TrafficMatchingListItem `json:"items"`
+}
+
+// TrafficMatchingListItem is a single entry of a traffic matching list.
+// Value is set for PORT_NUMBER, IP_ADDRESS and SUBNET items, Start and Stop for range items.
+type TrafficMatchingListItem struct {
+	ItemType TrafficMatchingListItemType `json:"type"`
+	Value    string                      `json:"value,omitempty"`
+	Start    string                      `json:"start,omitempty"`
+	Stop     string                      `json:"stop,omitempty"`
+}
+
+// PortItem returns a PORT_NUMBER item.
+func PortItem(port int) TrafficMatchingListItem {
+	return TrafficMatchingListItem{ItemType: TrafficMatchingListItemTypePortNumber, Value: strconv.Itoa(port)}
+}
+
+// PortRangeItem returns a PORT_NUMBER_RANGE item.
+func PortRangeItem(start, stop int) TrafficMatchingListItem {
+	return TrafficMatchingListItem{ItemType: TrafficMatchingListItemTypePortNumberRange, Start: strconv.Itoa(start), Stop: strconv.Itoa(stop)}
+}
+
+// IPAddressItem returns an IP_ADDRESS item.
+func IPAddressItem(address string) TrafficMatchingListItem {
+	return TrafficMatchingListItem{ItemType: TrafficMatchingListItemTypeIPAddress, Value: address}
+}
+
+// SubnetItem returns a SUBNET item in CIDR notation (e.g. "10.0.0.0/24").
+func SubnetItem(cidr string) TrafficMatchingListItem {
+	return TrafficMatchingListItem{ItemType: TrafficMatchingListItemTypeSubnet, Value: cidr}
+}
+
+// IPAddressRangeItem returns an IP_ADDRESS_RANGE item.
+func IPAddressRangeItem(r IpAddressRange) TrafficMatchingListItem {
+	return TrafficMatchingListItem{ItemType: TrafficMatchingListItemTypeIPAddressRange, Start: r.Start, Stop: r.Stop}
+}
+
+// validateTrafficMatchingList checks that every item is well formed and compatible with the list type.
+func validateTrafficMatchingList(listType TrafficMatchingListType, name string, items []TrafficMatchingListItem) error {
+	switch listType {
+	case TrafficMatchingListTypePorts, TrafficMatchingListTypeIPv4Addresses, TrafficMatchingListTypeIPv6Addresses:
+	default:
+		return errors.NewValidationError("type", fmt.Sprintf("must be %s, %s or %s",
+			TrafficMatchingListTypePorts, TrafficMatchingListTypeIPv4Addresses, TrafficMatchingListTypeIPv6Addresses))
+	}
+	if strings.TrimSpace(name) == "" {
+		return errors.NewValidationError("name", "cannot be empty")
+	}
+	if len(items) == 0 {
+		return errors.NewValidationError("items", "must contain at least one item")
+	}
+
+	for i, item := range items {
+		field := fmt.Sprintf("items[%d]", i)
+
+		var err error
+		if listType == TrafficMatchingListTypePorts {
+			err = item.validatePort(field)
+		} else {
+			err = item.validateAddress(field, listType == TrafficMatchingListTypeIPv4Addresses)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePort checks a PORT_NUMBER or PORT_NUMBER_RANGE item.
+func (i TrafficMatchingListItem) validatePort(field string) error {
+	switch i.ItemType {
+	case TrafficMatchingListItemTypePortNumber:
+		_, err := parsePort(field+".value", i.Value)
+		return err
+	case TrafficMatchingListItemTypePortNumberRange:
+		start, err := parsePort(field+".start", i.Start)
+		if err != nil {
+			return err
+		}
+		stop, err := parsePort(field+".stop", i.Stop)
+		if err != nil {
+			return err
+		}
+		if start > stop {
+			return errors.NewValidationError(field, fmt.Sprintf("start %d is after stop %d", start, stop))
+		}
+		return nil
+	default:
+		return errors.NewValidationError(field+".type", fmt.Sprintf("%s items cannot be used in %s lists", i.ItemType, TrafficMatchingListTypePorts))
+	}
+}
+
+// validateAddress checks an IP_ADDRESS, SUBNET or IP_ADDRESS_RANGE item against the list family.
+func (i TrafficMatchingListItem) validateAddress(field string, ipv4 bool) error {
+	family := "IPv6"
+	if ipv4 {
+		family = "IPv4"
+	}
+
+	var addr netip.Addr
+	switch i.ItemType {
+	case TrafficMatchingListItemTypeIPAddress:
+		a, err := netip.ParseAddr(i.Value)
+		if err != nil {
+			return errors.NewValidationError(field+".value", fmt.Sprintf("invalid IP address %q", i.Value))
+		}
+		addr = a
+	case TrafficMatchingListItemTypeSubnet:
+		p, err := netip.ParsePrefix(i.Value)
+		if err != nil {
+			return errors.NewValidationError(field+".value", fmt.Sprintf("invalid subnet %q", i.Value))
+		}
+		addr = p.Addr()
+	case TrafficMatchingListItemTypeIPAddressRange:
+		r := IpAddressRange{Start: i.Start, Stop: i.Stop}
+		if err := r.Validate(); err != nil {
+			var ve *errors.ValidationError
+			if !stderrors.As(err, &ve) {
+				return err
+			}
+			if ve.Field != "" {
+				return errors.NewValidationError(field+"."+ve.Field, ve.Message)
+			}
+			return errors.NewValidationError(field, ve.Message)
+		}
+		addr, _ = netip.ParseAddr(i.Start)
+	default:
+		return errors.NewValidationError(field+".type", fmt.Sprintf("%s items cannot be used in address lists", i.ItemType))
+	}
+
+	if addr.Unmap().Is4() != ipv4 {
+		return errors.NewValidationError(field, fmt.Sprintf("%s is not an %s address", addr, family))
+	}
+	return nil
+}
+
+// parsePort parses a TCP/UDP port number.
+func parsePort(field, value string) (int, error) {
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, errors.NewValidationError(field, fmt.Sprintf("invalid port %q", value))
+	}
+	return port, nil
+}
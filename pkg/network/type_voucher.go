@@ -0,0 +1,20 @@
+package network
+
+import "time"
+
+// Voucher represents a hotspot voucher.
+type Voucher struct {
+	ID                   string     `json:"id"`
+	CreatedAt            time.Time  `json:"createdAt"`
+	Name                 string     `json:"name"`
+	Code                 string     `json:"code"`
+	AuthorizedGuestLimit int        `json:"authorizedGuestLimit,omitempty"`
+	AuthorizedGuestCount int        `json:"authorizedGuestCount"`
+	ActivatedAt          *time.Time `json:"activatedAt,omitempty"`
+	ExpiresAt            *time.Time `json:"expiresAt,omitempty"`
+	Expired              bool       `json:"expired"`
+	TimeLimitMinutes     int        `json:"timeLimitMinutes"`
+	DataUsageLimitMBytes int        `json:"dataUsageLimitMBytes,omitempty"`
+	RxRateLimitKbps      int        `json:"rxRateLimitKbps,omitempty"`
+	TxRateLimitKbps      int        `json:"txRateLimitKbps,omitempty"`
+}
@@ -0,0 +1,68 @@
+package network
+
+type WLANSecurityMode string
+
+const (
+	WLANSecurityModeOpen             WLANSecurityMode = "OPEN"
+	WLANSecurityModeWPA2Personal     WLANSecurityMode = "WPA2_PERSONAL"
+	WLANSecurityModeWPA3Personal     WLANSecurityMode = "WPA3_PERSONAL"
+	WLANSecurityModeWPA2WPA3Personal WLANSecurityMode = "WPA2_WPA3_PERSONAL"
+	WLANSecurityModeWPA2Enterprise   WLANSecurityMode = "WPA2_ENTERPRISE"
+	WLANSecurityModeWPA3Enterprise   WLANSecurityMode = "WPA3_ENTERPRISE"
+)
+
+// IsPersonal reports whether the security mode authenticates with a shared passphrase.
+func (m WLANSecurityMode) IsPersonal() bool {
+	switch m {
+	case WLANSecurityModeWPA2Personal, WLANSecurityModeWPA3Personal, WLANSecurityModeWPA2WPA3Personal:
+		return true
+	default:
+		return false
+	}
+}
+
+type WLANNetworkType string
+
+const (
+	WLANNetworkTypeNative   WLANNetworkType = "NATIVE"
+	WLANNetworkTypeSpecific WLANNetworkType = "SPECIFIC"
+)
+
+// WLAN represents a WiFi broadcast (SSID).
+type WLAN struct {
+	ID                         string                    `json:"id"`
+	Name                       string                    `json:"name"`
+	Enabled                    bool                      `json:"enabled"`
+	Metadata                   NetworkDetailMetadata     `json:"metadata"`
+	Network                    WLANNetwork               `json:"network"`
+	SecurityConfiguration      WLANSecurityConfiguration `json:"securityConfiguration"`
+	HideName                   bool                      `json:"hideName"`
+	BandSteeringEnabled        bool                      `json:"bandSteeringEnabled"`
+	BroadcastingFrequenciesGHz []float64                 `json:"broadcastingFrequenciesGHz"`
+	ClientIsolationEnabled     bool                      `json:"clientIsolationEnabled"`
+	Schedule                   *WLANSchedule             `json:"schedule,omitempty"`
+}
+
+// WLANNetwork binds a WiFi broadcast to a network.
+type WLANNetwork struct {
+	WLANNetworkType WLANNetworkType `json:"type"`
+	NetworkID       string          `json:"networkId,omitempty"`
+}
+
+type WLANSecurityConfiguration struct {
+	WLANSecurityMode WLANSecurityMode `json:"type"`
+	Passphrase       string           `json:"passphrase,omitempty"`
+	RadiusProfileID  string           `json:"radiusProfileId,omitempty"`
+}
+
+// WLANSchedule limits broadcasting to the given time ranges.
+type WLANSchedule struct {
+	Entries []WLANScheduleEntry `json:"entries"`
+}
+
+type WLANScheduleEntry struct {
+	DayOfWeek string `json:"dayOfWeek"`
+	// StartTime and EndTime use the "15:04" format.
+	StartTime string `json:"startTime"`
+	EndTime   string `json:"endTime"`
+}
@@ -1,6 +1,13 @@
 package network
 
-import "time"
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
 
 type ListAdoptedDevicesRequest struct {
 	SiteID string `json:"siteId"`
@@ -9,6 +16,14 @@ type ListAdoptedDevicesRequest struct {
 	Filter string `json:"filter"`
 }
 
+// ToQuery converts the request to URL query string.
+func (r *ListAdoptedDevicesRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
 type ListAdoptedDevicesResponse struct {
 	Offset     int                     `json:"offset"`
 	Limit      int                     `json:"limit"`
@@ -33,8 +48,50 @@ type AdoptedDeviceOverview struct {
 
 // GET /v1/sites/{siteId}/devices
 
+// ListDevices retrieves the devices adopted by a site.
+func (n *Network) ListDevices(ctx context.Context, req *ListAdoptedDevicesRequest) (*ListAdoptedDevicesResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/devices", req.SiteID) + req.ToQuery()
+
+	var resp ListAdoptedDevicesResponse
+	if err := n.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// findDeviceByMAC returns the adopted device with the given MAC address.
+// The address may be written in any format accepted by NormalizeMAC.
+func (n *Network) findDeviceByMAC(ctx context.Context, siteID, macAddress string) (*AdoptedDeviceOverview, error) {
+	normalized, err := NormalizeMAC(macAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	devices, err := listAll(func(offset int) ([]AdoptedDeviceOverview, int, error) {
+		resp, err := n.ListDevices(ctx, &ListAdoptedDevicesRequest{SiteID: siteID, Offset: offset, Limit: pageLimit})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Data, resp.TotalCount, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range devices {
+		if mac, err := NormalizeMAC(devices[i].MacAddress); err == nil && mac == normalized {
+			return &devices[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", errors.ErrDeviceNotFound, macAddress)
+}
+
 type AdoptDeviceRequest struct {
-	SiteID            string `json:"siteId"`
+	SiteID            string `json:"-"`
 	MacAddress        string `json:"macAddress"`
 	IgnoreDeviceLimit bool   `json:"ignoreDeviceLimit"`
 }
@@ -45,23 +102,120 @@ type AdoptDeviceResponse struct {
 
 // POST /v1/sites/{siteId}/devices
 
+// AdoptDevice adopts a pending device into a site.
+func (n *Network) AdoptDevice(ctx context.Context, req *AdoptDeviceRequest) (*AdoptDeviceResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.MacAddress) == "" {
+		return nil, errors.NewValidationError("macAddress", "cannot be empty")
+	}
+
+	var resp AdoptDeviceResponse
+	if err := n.post(ctx, fmt.Sprintf("/v1/sites/%s/devices", req.SiteID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type PortAction string
+
+const (
+	PortActionPowerCycle PortAction = "POWER_CYCLE"
+)
+
 type ExecutePortActionRequest struct {
-	PortIDx  int    `json:"portIdx"`
-	SiteID   string `json:"siteId"`
-	DeviceID string `json:"deviceId"`
-	Action   string `json:"action"`
+	PortIDx  int        `json:"-"`
+	SiteID   string     `json:"-"`
+	DeviceID string     `json:"-"`
+	Action   PortAction `json:"action"`
 }
 
 // POST /v1/sites/{siteId}/devices/{deviceId}/interfaces/ports/{portIdx}/actions
 
+// ExecutePortAction performs an action on a port of an adopted device.
+func (n *Network) ExecutePortAction(ctx context.Context, req *ExecutePortActionRequest) error {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.DeviceID) == "" {
+		return errors.ErrEmptyDeviceID
+	}
+	if strings.TrimSpace(string(req.Action)) == "" {
+		return errors.NewValidationError("action", "cannot be empty")
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/devices/%s/interfaces/ports/%d/actions", req.SiteID, req.DeviceID, req.PortIDx)
+	return n.post(ctx, path, req, nil)
+}
+
+// PowerCyclePort power-cycles a PoE port of the adopted device with the given MAC address.
+// It verifies that the port exists and supplies PoE before sending the action.
+func (n *Network) PowerCyclePort(ctx context.Context, siteID, macAddress string, portIdx int) error {
+	if strings.TrimSpace(siteID) == "" {
+		return errors.ErrEmptySiteID
+	}
+
+	overview, err := n.findDeviceByMAC(ctx, siteID, macAddress)
+	if err != nil {
+		return err
+	}
+
+	device, err := n.GetDevice(ctx, &AdoptDeviceDetailRequest{SiteID: siteID, DeviceID: overview.ID})
+	if err != nil {
+		return err
+	}
+
+	port, ok := device.Interfaces.port(portIdx)
+	if !ok {
+		return errors.NewValidationError("portIdx", fmt.Sprintf("port %d does not exist on device %s", portIdx, macAddress))
+	}
+	if port.Poe.Standard == "" {
+		return errors.NewValidationError("portIdx", fmt.Sprintf("port %d on device %s is not PoE-capable", portIdx, macAddress))
+	}
+	if !port.Poe.Enabled {
+		return errors.NewValidationError("portIdx", fmt.Sprintf("PoE is disabled on port %d of device %s", portIdx, macAddress))
+	}
+
+	return n.ExecutePortAction(ctx, &ExecutePortActionRequest{
+		SiteID:   siteID,
+		DeviceID: device.ID,
+		PortIDx:  portIdx,
+		Action:   PortActionPowerCycle,
+	})
+}
+
+type DeviceAction string
+
+const (
+	DeviceActionRestart   DeviceAction = "RESTART"
+	DeviceActionLocateOn  DeviceAction = "LOCATE_ON"
+	DeviceActionLocateOff DeviceAction = "LOCATE_OFF"
+)
+
 type ExecuteAdoptDeviceActionRequest struct {
-	SiteID   string `json:"siteId"`
-	DeviceID string `json:"deviceId"`
-	Action   string `json:"action"`
+	SiteID   string       `json:"-"`
+	DeviceID string       `json:"-"`
+	Action   DeviceAction `json:"action"`
 }
 
 // POST /v1/sites/{siteId}/devices/{deviceId}/actions
 
+// ExecuteDeviceAction performs an action on an adopted device.
+func (n *Network) ExecuteDeviceAction(ctx context.Context, req *ExecuteAdoptDeviceActionRequest) error {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.DeviceID) == "" {
+		return errors.ErrEmptyDeviceID
+	}
+	if strings.TrimSpace(string(req.Action)) == "" {
+		return errors.NewValidationError("action", "cannot be empty")
+	}
+
+	return n.post(ctx, fmt.Sprintf("/v1/sites/%s/devices/%s/actions", req.SiteID, req.DeviceID), req, nil)
+}
+
 type AdoptDeviceDetailRequest struct {
 	SiteID   string `json:"siteId"`
 	DeviceID string `json:"deviceId"`
@@ -73,6 +227,22 @@ type AdoptDeviceDetailResponse struct {
 
 // GET /v1/sites/{siteId}/devices/{deviceId}
 
+// GetDevice retrieves the details of a single adopted device.
+func (n *Network) GetDevice(ctx context.Context, req *AdoptDeviceDetailRequest) (*AdoptDeviceDetailResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.DeviceID) == "" {
+		return nil, errors.ErrEmptyDeviceID
+	}
+
+	var resp AdoptDeviceDetailResponse
+	if err := n.get(ctx, fmt.Sprintf("/v1/sites/%s/devices/%s", req.SiteID, req.DeviceID), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 type LatestAdoptedDeviceStatisticsRequest struct {
 	SiteID   string `json:"siteId"`
 	DeviceID string `json:"deviceId"`
@@ -107,12 +277,38 @@ type LatestAdoptedDeviceStatisticsInterfaceRadio struct {
 
 // GET /v1/sites/{siteId}/devices/{deviceId}/statistics/latest
 
+// GetLatestDeviceStatistics retrieves the latest real-time statistics of an adopted device.
+func (n *Network) GetLatestDeviceStatistics(ctx context.Context, req *LatestAdoptedDeviceStatisticsRequest) (*LatestAdoptedDeviceStatisticsResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.DeviceID) == "" {
+		return nil, errors.ErrEmptyDeviceID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/devices/%s/statistics/latest", req.SiteID, req.DeviceID)
+
+	var resp LatestAdoptedDeviceStatisticsResponse
+	if err := n.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 type DevicesPendingAdoptionRequest struct {
 	Offset int    `json:"offset"`
 	Limit  int    `json:"limit"`
 	Filter string `json:"filter"`
 }
 
+// ToQuery converts the request to URL query string.
+func (r *DevicesPendingAdoptionRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
 type DevicesPendingAdoptionResponse struct {
 	Offset     int                          `json:"offset"`
 	Limit      int                          `json:"limit"`
@@ -133,3 +329,12 @@ type DevicesPendingAdoptionData struct {
 }
 
 // GET /v1/pending-devices
+
+// ListPendingDevices retrieves the devices that are waiting to be adopted.
+func (n *Network) ListPendingDevices(ctx context.Context, req *DevicesPendingAdoptionRequest) (*DevicesPendingAdoptionResponse, error) {
+	var resp DevicesPendingAdoptionResponse
+	if err := n.get(ctx, "/v1/pending-devices"+req.ToQuery(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,153 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pkgerrors "github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// TestNetwork_PowerCyclePort tests port validation before a PoE power cycle.
+func TestNetwork_PowerCyclePort(t *testing.T) {
+	t.Parallel()
+
+	device := AdoptDevice{
+		ID:         "dev-1",
+		MacAddress: "aa:bb:cc:dd:ee:ff",
+		Interfaces: AdoptDeviceInterface{
+			Ports: []AdoptDeviceInterfacePort{
+				{Idx: 1, Poe: AdoptDeviceInterfacePortPoE{Standard: "802.3at", Enabled: true}},
+				{Idx: 2},
+				{Idx: 3, Poe: AdoptDeviceInterfacePortPoE{Standard: "802.3af", Enabled: false}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		mac        string
+		portIdx    int
+		wantAction bool
+		wantErr    func(error) bool
+	}{
+		{
+			name:       "PoE port is power-cycled",
+			mac:        "AA:BB:CC:DD:EE:FF",
+			portIdx:    1,
+			wantAction: true,
+			wantErr:    func(err error) bool { return err == nil },
+		},
+		{
+			name:       "dashed MAC address",
+			mac:        "AA-BB-CC-DD-EE-FF",
+			portIdx:    1,
+			wantAction: true,
+			wantErr:    func(err error) bool { return err == nil },
+		},
+		{
+			name:       "bare MAC address",
+			mac:        "aabbccddeeff",
+			portIdx:    1,
+			wantAction: true,
+			wantErr:    func(err error) bool { return err == nil },
+		},
+		{
+			name:    "invalid MAC address",
+			mac:     "aa:bb:cc",
+			portIdx: 1,
+			wantErr: pkgerrors.IsValidationError,
+		},
+		{
+			name:    "unknown device",
+			mac:     "11:22:33:44:55:66",
+			portIdx: 1,
+			wantErr: func(err error) bool { return errors.Is(err, pkgerrors.ErrDeviceNotFound) },
+		},
+		{
+			name:    "missing port",
+			mac:     "aa:bb:cc:dd:ee:ff",
+			portIdx: 9,
+			wantErr: pkgerrors.IsValidationError,
+		},
+		{
+			name:    "port without PoE",
+			mac:     "aa:bb:cc:dd:ee:ff",
+			portIdx: 2,
+			wantErr: pkgerrors.IsValidationError,
+		},
+		{
+			name:    "port with PoE disabled",
+			mac:     "aa:bb:cc:dd:ee:ff",
+			portIdx: 3,
+			wantErr: pkgerrors.IsValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var action bool
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/":
+					w.WriteHeader(http.StatusOK)
+				case "/proxy/network/integration/v1/sites/site-1/devices":
+					json.NewEncoder(w).Encode(ListAdoptedDevicesResponse{
+						Count:      1,
+						TotalCount: 1,
+						Data:       []AdoptedDeviceOverview{{ID: device.ID, MacAddress: device.MacAddress}},
+					})
+				case "/proxy/network/integration/v1/sites/site-1/devices/dev-1":
+					json.NewEncoder(w).Encode(device)
+				case "/proxy/network/integration/v1/sites/site-1/devices/dev-1/interfaces/ports/1/actions":
+					action = true
+					var body map[string]interface{}
+					json.NewDecoder(r.Body).Decode(&body)
+					if len(body) != 1 || body["action"] != string(PortActionPowerCycle) {
+						t.Errorf("body = %v, want only action %q", body, PortActionPowerCycle)
+					}
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			n := newTestNetwork(t, server, Config{APIKey: "key"})
+
+			err := n.PowerCyclePort(context.Background(), "site-1", tt.mac, tt.portIdx)
+			if !tt.wantErr(err) {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if action != tt.wantAction {
+				t.Errorf("action sent = %v, want %v", action, tt.wantAction)
+			}
+		})
+	}
+}
+
+// TestNetwork_ExecuteActionsRequireAction tests that device and port actions reject an empty action.
+func TestNetwork_ExecuteActionsRequireAction(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	n := newTestNetwork(t, server, Config{APIKey: "key"})
+	ctx := context.Background()
+
+	err := n.ExecutePortAction(ctx, &ExecutePortActionRequest{SiteID: "site-1", DeviceID: "dev-1", PortIDx: 1})
+	if !pkgerrors.IsValidationError(err) {
+		t.Errorf("ExecutePortAction() error = %v, want validation error", err)
+	}
+	err = n.ExecuteDeviceAction(ctx, &ExecuteAdoptDeviceActionRequest{SiteID: "site-1", DeviceID: "dev-1", Action: " "})
+	if !pkgerrors.IsValidationError(err) {
+		t.Errorf("ExecuteDeviceAction() error = %v, want validation error", err)
+	}
+}
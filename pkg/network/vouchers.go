@@ -0,0 +1,226 @@
+package network
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+type ListVouchersRequest struct {
+	SiteID string `json:"siteId"`
+
+	Offset int    `json:"offset"`
+	Limit  int    `json:"limit"`
+	Filter string `json:"filter"`
+}
+
+// ToQuery converts the request to URL query string.
+func (r *ListVouchersRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
+type ListVouchersResponse struct {
+	Offset     int       `json:"offset"`
+	Limit      int       `json:"limit"`
+	Count      int       `json:"count"`
+	TotalCount int       `json:"totalCount"`
+	Data       []Voucher `json:"data"`
+}
+
+// GET /v1/sites/{siteId}/hotspot/vouchers
+
+// ListVouchers retrieves the hotspot vouchers of a site.
+func (n *Network) ListVouchers(ctx context.Context, req *ListVouchersRequest) (*ListVouchersResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/hotspot/vouchers", req.SiteID) + req.ToQuery()
+
+	var resp ListVouchersResponse
+	if err := n.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type VoucherDetailsRequest struct {
+	VoucherID string `json:"voucherId"`
+	SiteID    string `json:"siteId"`
+}
+
+type VoucherDetailsResponse struct {
+	Voucher
+}
+
+// GET /v1/sites/{siteId}/hotspot/vouchers/{voucherId}
+
+// GetVoucher retrieves a single hotspot voucher.
+func (n *Network) GetVoucher(ctx context.Context, req *VoucherDetailsRequest) (*VoucherDetailsResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.VoucherID) == "" {
+		return nil, errors.ErrEmptyVoucherID
+	}
+
+	var resp VoucherDetailsResponse
+	if err := n.get(ctx, fmt.Sprintf("/v1/sites/%s/hotspot/vouchers/%s", req.SiteID, req.VoucherID), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type CreateVouchersRequest struct {
+	SiteID string `json:"-"`
+
+	Count                int    `json:"count"`
+	Name                 string `json:"name"`
+	AuthorizedGuestLimit int    `json:"authorizedGuestLimit,omitempty"`
+	TimeLimitMinutes     int    `json:"timeLimitMinutes"`
+	DataUsageLimitMBytes int    `json:"dataUsageLimitMBytes,omitempty"`
+	RxRateLimitKbps      int    `json:"rxRateLimitKbps,omitempty"`
+	TxRateLimitKbps      int    `json:"txRateLimitKbps,omitempty"`
+}
+
+type CreateVouchersResponse struct {
+	Vouchers []Voucher `json:"vouchers"`
+}
+
+// POST /v1/sites/{siteId}/hotspot/vouchers
+
+// CreateVouchers generates one or more hotspot vouchers with the same limits.
+func (n *Network) CreateVouchers(ctx context.Context, req *CreateVouchersRequest) (*CreateVouchersResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if req.Count < 1 {
+		return nil, errors.NewValidationError("count", "must be at least 1")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, errors.NewValidationError("name", "cannot be empty")
+	}
+	if req.TimeLimitMinutes < 1 {
+		return nil, errors.NewValidationError("timeLimitMinutes", "must be at least 1")
+	}
+
+	var resp CreateVouchersResponse
+	if err := n.post(ctx, fmt.Sprintf("/v1/sites/%s/hotspot/vouchers", req.SiteID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type DeleteVoucherRequest struct {
+	VoucherID string `json:"voucherId"`
+	SiteID    string `json:"siteId"`
+}
+
+// DELETE /v1/sites/{siteId}/hotspot/vouchers/{voucherId}
+
+// DeleteVoucher deletes a hotspot voucher.
+func (n *Network) DeleteVoucher(ctx context.Context, req *DeleteVoucherRequest) error {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.VoucherID) == "" {
+		return errors.ErrEmptyVoucherID
+	}
+
+	return n.delete(ctx, fmt.Sprintf("/v1/sites/%s/hotspot/vouchers/%s", req.SiteID, req.VoucherID), nil)
+}
+
+// FormatVoucherCode formats a voucher code the way the UniFi portal displays it (e.g. "12345-67890").
+func FormatVoucherCode(code string) string {
+	if len(code) != 10 {
+		return code
+	}
+	return code[:5] + "-" + code[5:]
+}
+
+// WriteVouchersCSV writes vouchers as CSV with a header row.
+func WriteVouchersCSV(w io.Writer, vouchers []Voucher) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write([]string{"code", "name", "time_limit_minutes", "data_limit_mbytes", "rx_rate_limit_kbps", "tx_rate_limit_kbps", "guest_limit"}); err != nil {
+		return err
+	}
+	for _, v := range vouchers {
+		record := []string{
+			FormatVoucherCode(v.Code),
+			v.Name,
+			strconv.Itoa(v.TimeLimitMinutes),
+			strconv.Itoa(v.DataUsageLimitMBytes),
+			strconv.Itoa(v.RxRateLimitKbps),
+			strconv.Itoa(v.TxRateLimitKbps),
+			strconv.Itoa(v.AuthorizedGuestLimit),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteVoucherTickets writes vouchers as a plain text ticket sheet, one cut-out ticket per voucher.
+func WriteVoucherTickets(w io.Writer, vouchers []Voucher) error {
+	const separator = "+--------------------------------+\n"
+
+	for _, v := range vouchers {
+		lines := []string{
+			"WiFi voucher",
+			"",
+			"Code: " + FormatVoucherCode(v.Code),
+			"Valid for: " + formatMinutes(v.TimeLimitMinutes),
+		}
+		if v.DataUsageLimitMBytes > 0 {
+			lines = append(lines, fmt.Sprintf("Data limit: %d MB", v.DataUsageLimitMBytes))
+		}
+		if v.AuthorizedGuestLimit > 0 {
+			lines = append(lines, fmt.Sprintf("Devices: %d", v.AuthorizedGuestLimit))
+		}
+
+		if _, err := io.WriteString(w, separator); err != nil {
+			return err
+		}
+		for _, line := range lines {
+			if _, err := fmt.Fprintf(w, "| %-30s |\n", line); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(vouchers) > 0 {
+		if _, err := io.WriteString(w, separator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatMinutes formats a duration in minutes as days, hours and minutes.
+func formatMinutes(minutes int) string {
+	days, hours, mins := minutes/(24*60), minutes/60%24, minutes%60
+
+	var parts []string
+	if days > 0 {
+		parts = append(parts, fmt.Sprintf("%dd", days))
+	}
+	if hours > 0 {
+		parts = append(parts, fmt.Sprintf("%dh", hours))
+	}
+	if mins > 0 || len(parts) == 0 {
+		parts = append(parts, fmt.Sprintf("%dm", mins))
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,69 @@
+package network
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestWriteVouchersCSV tests the CSV export of vouchers.
+func TestWriteVouchersCSV(t *testing.T) {
+	t.Parallel()
+
+	vouchers := []Voucher{
+		{Code: "1234567890", Name: "Front desk", TimeLimitMinutes: 1440, DataUsageLimitMBytes: 500, AuthorizedGuestLimit: 1},
+		{Code: "0987654321", Name: "Lobby, east", TimeLimitMinutes: 60},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteVouchersCSV(&buf, vouchers); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "code,name,time_limit_minutes,data_limit_mbytes,rx_rate_limit_kbps,tx_rate_limit_kbps,guest_limit\n" +
+		"12345-67890,Front desk,1440,500,0,0,1\n" +
+		"09876-54321,\"Lobby, east\",60,0,0,0,0\n"
+	if got := buf.String(); got != want {
+		t.Errorf("CSV = %q, want %q", got, want)
+	}
+}
+
+// TestWriteVoucherTickets tests the text ticket sheet of vouchers.
+func TestWriteVoucherTickets(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	err := WriteVoucherTickets(&buf, []Voucher{
+		{Code: "1234567890", TimeLimitMinutes: 1530, DataUsageLimitMBytes: 100},
+		{Code: "0987654321", TimeLimitMinutes: 30},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := buf.String()
+	for _, want := range []string{"Code: 12345-67890", "Valid for: 1d 1h 30m", "Data limit: 100 MB", "Code: 09876-54321", "Valid for: 30m"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("ticket sheet does not contain %q:\n%s", want, got)
+		}
+	}
+	if n := strings.Count(got, "+--"); n != 3 {
+		t.Errorf("separators = %d, want 3", n)
+	}
+}
+
+// TestFormatVoucherCode tests voucher code formatting.
+func TestFormatVoucherCode(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"1234567890": "12345-67890",
+		"12345":      "12345",
+		"":           "",
+	}
+	for code, want := range tests {
+		if got := FormatVoucherCode(code); got != want {
+			t.Errorf("FormatVoucherCode(%q) = %q, want %q", code, got, want)
+		}
+	}
+}
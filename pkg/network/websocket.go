@@ -0,0 +1,226 @@
+package network
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// websocketGUID is appended to the handshake key to compute Sec-WebSocket-Accept (RFC 6455, section 1.3).
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// maxWebSocketMessage bounds the size of a single message read from the controller.
+const maxWebSocketMessage = 16 << 20
+
+const (
+	wsOpContinuation = 0x0
+	wsOpText         = 0x1
+	wsOpBinary       = 0x2
+	wsOpClose        = 0x8
+	wsOpPing         = 0x9
+	wsOpPong         = 0xA
+)
+
+// wsConn is a minimal RFC 6455 client connection, sufficient for the controller event stream.
+type wsConn struct {
+	rw io.ReadWriteCloser
+	br *bufio.Reader
+
+	writeMu   sync.Mutex
+	closeOnce sync.Once
+}
+
+// dialWebSocket opens a websocket on a controller path.
+// The handshake goes through the client transport, so it carries the session cookie, the API key and the TLS settings.
+func (n *Network) dialWebSocket(ctx context.Context, path string) (*wsConn, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate websocket key: %w", err)
+	}
+	key := base64.StdEncoding.EncodeToString(nonce)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, n.baseURL+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", key)
+	if n.apiKey != "" {
+		req.Header.Set("X-API-KEY", n.apiKey)
+	}
+
+	// The client timeout covers reading the whole body, which would cut long-lived streams.
+	client := *n.httpClient
+	client.Timeout = 0
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+		return nil, errors.NewAPIError(resp.StatusCode, string(body), resp.Header.Get("X-Request-Id"))
+	}
+
+	rw, ok := resp.Body.(io.ReadWriteCloser)
+	if !ok {
+		resp.Body.Close()
+		return nil, fmt.Errorf("websocket upgrade is not supported by the HTTP transport")
+	}
+	if resp.Header.Get("Sec-WebSocket-Accept") != websocketAccept(key) {
+		rw.Close()
+		return nil, fmt.Errorf("invalid websocket handshake: unexpected Sec-WebSocket-Accept")
+	}
+
+	return &wsConn{rw: rw, br: bufio.NewReader(rw)}, nil
+}
+
+// websocketAccept computes the Sec-WebSocket-Accept value expected for a handshake key.
+func websocketAccept(key string) string {
+	sum := sha1.Sum([]byte(key + websocketGUID))
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// ReadMessage returns the next text or binary message, answering pings on the way.
+// It returns io.EOF once the controller closes the connection.
+func (c *wsConn) ReadMessage() ([]byte, error) {
+	var message []byte
+	for {
+		fin, opcode, payload, err := c.readFrame()
+		if err != nil {
+			return nil, err
+		}
+
+		switch opcode {
+		case wsOpPing:
+			if err := c.writeFrame(wsOpPong, payload); err != nil {
+				return nil, err
+			}
+			continue
+		case wsOpPong:
+			continue
+		case wsOpClose:
+			_ = c.writeFrame(wsOpClose, payload)
+			return nil, io.EOF
+		case wsOpText, wsOpBinary, wsOpContinuation:
+		default:
+			return nil, fmt.Errorf("unexpected websocket opcode %#x", opcode)
+		}
+
+		message = append(message, payload...)
+		if len(message) > maxWebSocketMessage {
+			return nil, fmt.Errorf("websocket message exceeds %d bytes", maxWebSocketMessage)
+		}
+		if fin {
+			return message, nil
+		}
+	}
+}
+
+func (c *wsConn) readFrame() (fin bool, opcode byte, payload []byte, err error) {
+	var header [2]byte
+	if _, err := io.ReadFull(c.br, header[:]); err != nil {
+		return false, 0, nil, err
+	}
+	fin = header[0]&0x80 != 0
+	opcode = header[0] & 0x0f
+	masked := header[1]&0x80 != 0
+
+	length := uint64(header[1] & 0x7f)
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.br, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.br, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+	if length > maxWebSocketMessage {
+		return false, 0, nil, fmt.Errorf("websocket frame exceeds %d bytes", maxWebSocketMessage)
+	}
+
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(c.br, mask[:]); err != nil {
+			return false, 0, nil, err
+		}
+	}
+
+	payload = make([]byte, length)
+	if _, err := io.ReadFull(c.br, payload); err != nil {
+		return false, 0, nil, err
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+	return fin, opcode, payload, nil
+}
+
+// writeFrame writes a single masked frame, as required for client frames.
+func (c *wsConn) writeFrame(opcode byte, payload []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return c.writeFrameLocked(opcode, payload)
+}
+
+func (c *wsConn) writeFrameLocked(opcode byte, payload []byte) error {
+	frame := make([]byte, 0, 14+len(payload))
+	frame = append(frame, 0x80|opcode)
+	switch {
+	case len(payload) < 126:
+		frame = append(frame, 0x80|byte(len(payload)))
+	case len(payload) <= 0xffff:
+		frame = append(frame, 0x80|126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(len(payload)))
+	default:
+		frame = append(frame, 0x80|127)
+		frame = binary.BigEndian.AppendUint64(frame, uint64(len(payload)))
+	}
+
+	var mask [4]byte
+	if _, err := rand.Read(mask[:]); err != nil {
+		return err
+	}
+	frame = append(frame, mask[:]...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+
+	_, err := c.rw.Write(frame)
+	return err
+}
+
+// Close sends a normal closure frame and closes the connection. It is safe to call more than once.
+// The closure frame is skipped if another write is in progress, so Close never waits on a stuck peer.
+func (c *wsConn) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		if c.writeMu.TryLock() {
+			_ = c.writeFrameLocked(wsOpClose, []byte{0x03, 0xe8})
+			c.writeMu.Unlock()
+		}
+		err = c.rw.Close()
+	})
+	return err
+}
@@ -0,0 +1,254 @@
+package network
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+type ListWLANsRequest struct {
+	SiteID string `json:"siteId"`
+
+	Offset int    `json:"offset"`
+	Limit  int    `json:"limit"`
+	Filter string `json:"filter"`
+}
+
+// ToQuery converts the request to URL query string.
+func (r *ListWLANsRequest) ToQuery() string {
+	if r == nil {
+		return ""
+	}
+	return pageQuery(r.Offset, r.Limit, r.Filter)
+}
+
+type ListWLANsResponse struct {
+	Offset     int    `json:"offset"`
+	Limit      int    `json:"limit"`
+	Count      int    `json:"count"`
+	TotalCount int    `json:"totalCount"`
+	Data       []WLAN `json:"data"`
+}
+
+// GET /v1/sites/{siteId}/wifi/broadcasts
+
+// ListWLANs retrieves the WiFi broadcasts of a site.
+func (n *Network) ListWLANs(ctx context.Context, req *ListWLANsRequest) (*ListWLANsResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/wifi/broadcasts", req.SiteID) + req.ToQuery()
+
+	var resp ListWLANsResponse
+	if err := n.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type WLANDetailsRequest struct {
+	WLANID string `json:"wlanId"`
+	SiteID string `json:"siteId"`
+}
+
+type WLANDetailsResponse struct {
+	WLAN
+}
+
+// GET /v1/sites/{siteId}/wifi/broadcasts/{wlanId}
+
+// GetWLAN retrieves a single WiFi broadcast.
+func (n *Network) GetWLAN(ctx context.Context, req *WLANDetailsRequest) (*WLANDetailsResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.WLANID) == "" {
+		return nil, errors.ErrEmptyWLANID
+	}
+
+	var resp WLANDetailsResponse
+	if err := n.get(ctx, fmt.Sprintf("/v1/sites/%s/wifi/broadcasts/%s", req.SiteID, req.WLANID), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type CreateWLANRequest struct {
+	SiteID string `json:"-"`
+
+	Name                       string                    `json:"name"`
+	Enabled                    bool                      `json:"enabled"`
+	Network                    WLANNetwork               `json:"network"`
+	SecurityConfiguration      WLANSecurityConfiguration `json:"securityConfiguration"`
+	HideName                   bool                      `json:"hideName"`
+	BandSteeringEnabled        bool                      `json:"bandSteeringEnabled"`
+	BroadcastingFrequenciesGHz []float64                 `json:"broadcastingFrequenciesGHz,omitempty"`
+	ClientIsolationEnabled     bool                      `json:"clientIsolationEnabled"`
+	Schedule                   *WLANSchedule             `json:"schedule,omitempty"`
+}
+
+type CreateWLANResponse struct {
+	WLAN
+}
+
+// POST /v1/sites/{siteId}/wifi/broadcasts
+
+// CreateWLAN creates a new WiFi broadcast.
+func (n *Network) CreateWLAN(ctx context.Context, req *CreateWLANRequest) (*CreateWLANResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, errors.NewValidationError("name", "cannot be empty")
+	}
+
+	var resp CreateWLANResponse
+	if err := n.post(ctx, fmt.Sprintf("/v1/sites/%s/wifi/broadcasts", req.SiteID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type UpdateWLANRequest struct {
+	WLANID string `json:"-"`
+	SiteID string `json:"-"`
+
+	Name                       string                    `json:"name"`
+	Enabled                    bool                      `json:"enabled"`
+	Network                    WLANNetwork               `json:"network"`
+	SecurityConfiguration      WLANSecurityConfiguration `json:"securityConfiguration"`
+	HideName                   bool                      `json:"hideName"`
+	BandSteeringEnabled        bool                      `json:"bandSteeringEnabled"`
+	BroadcastingFrequenciesGHz []float64                 `json:"broadcastingFrequenciesGHz,omitempty"`
+	ClientIsolationEnabled     bool                      `json:"clientIsolationEnabled"`
+	Schedule                   *WLANSchedule             `json:"schedule,omitempty"`
+}
+
+type UpdateWLANResponse struct {
+	WLAN
+}
+
+// PUT /v1/sites/{siteId}/wifi/broadcasts/{wlanId}
+
+// UpdateWLAN replaces the whole configuration of a WiFi broadcast. This is not a partial update:
+// fields left unset in req, and settings the SDK does not model, are reset to their defaults.
+// Use GetWLAN to start from the current configuration.
+func (n *Network) UpdateWLAN(ctx context.Context, req *UpdateWLANRequest) (*UpdateWLANResponse, error) {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return nil, errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.WLANID) == "" {
+		return nil, errors.ErrEmptyWLANID
+	}
+
+	var resp UpdateWLANResponse
+	if err := n.put(ctx, fmt.Sprintf("/v1/sites/%s/wifi/broadcasts/%s", req.SiteID, req.WLANID), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type DeleteWLANRequest struct {
+	WLANID string `json:"wlanId"`
+	SiteID string `json:"siteId"`
+}
+
+// DELETE /v1/sites/{siteId}/wifi/broadcasts/{wlanId}
+
+// DeleteWLAN deletes a WiFi broadcast.
+func (n *Network) DeleteWLAN(ctx context.Context, req *DeleteWLANRequest) error {
+	if req == nil || strings.TrimSpace(req.SiteID) == "" {
+		return errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(req.WLANID) == "" {
+		return errors.ErrEmptyWLANID
+	}
+
+	return n.delete(ctx, fmt.Sprintf("/v1/sites/%s/wifi/broadcasts/%s", req.SiteID, req.WLANID), nil)
+}
+
+// passphraseAlphabet omits characters that are easily confused when typed by hand.
+const passphraseAlphabet = "abcdefghjkmnpqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// DefaultPassphraseLength is the length of passphrases generated by RotateWLANPassphrase.
+const DefaultPassphraseLength = 20
+
+// RotateWLANPassphrase sets a new random passphrase on a WPA personal WiFi broadcast
+// and returns the new passphrase.
+//
+// The broadcast is updated with a full replacement (PUT) built from the raw configuration returned by
+// the controller, so settings the SDK does not model are sent back unchanged.
+func (n *Network) RotateWLANPassphrase(ctx context.Context, siteID, wlanID string) (string, error) {
+	if strings.TrimSpace(siteID) == "" {
+		return "", errors.ErrEmptySiteID
+	}
+	if strings.TrimSpace(wlanID) == "" {
+		return "", errors.ErrEmptyWLANID
+	}
+
+	path := fmt.Sprintf("/v1/sites/%s/wifi/broadcasts/%s", siteID, wlanID)
+
+	var raw json.RawMessage
+	if err := n.get(ctx, path, &raw); err != nil {
+		return "", err
+	}
+
+	var current WLAN
+	if err := json.Unmarshal(raw, &current); err != nil {
+		return "", fmt.Errorf("failed to decode WLAN: %w", err)
+	}
+	if !current.SecurityConfiguration.WLANSecurityMode.IsPersonal() {
+		return "", errors.NewValidationError("securityConfiguration", fmt.Sprintf("WLAN %s uses %s security and has no passphrase", wlanID, current.SecurityConfiguration.WLANSecurityMode))
+	}
+
+	// Only the passphrase is replaced; every other value is sent back exactly as it was received.
+	var config map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &config); err != nil {
+		return "", fmt.Errorf("failed to decode WLAN: %w", err)
+	}
+	var security map[string]json.RawMessage
+	if err := json.Unmarshal(config["securityConfiguration"], &security); err != nil || security == nil {
+		return "", fmt.Errorf("WLAN %s has no security configuration", wlanID)
+	}
+
+	passphrase, err := generatePassphrase(DefaultPassphraseLength)
+	if err != nil {
+		return "", err
+	}
+	if security["passphrase"], err = json.Marshal(passphrase); err != nil {
+		return "", fmt.Errorf("failed to encode passphrase: %w", err)
+	}
+	if config["securityConfiguration"], err = json.Marshal(security); err != nil {
+		return "", fmt.Errorf("failed to encode security configuration: %w", err)
+	}
+
+	// The ID and metadata are read-only and not part of an update.
+	delete(config, "id")
+	delete(config, "metadata")
+
+	if err := n.put(ctx, path, config, nil); err != nil {
+		return "", err
+	}
+	return passphrase, nil
+}
+
+// generatePassphrase returns a cryptographically random passphrase of the given length.
+func generatePassphrase(length int) (string, error) {
+	alphabetSize := big.NewInt(int64(len(passphraseAlphabet)))
+
+	var sb strings.Builder
+	for i := 0; i < length; i++ {
+		idx, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate passphrase: %w", err)
+		}
+		sb.WriteByte(passphraseAlphabet[idx.Int64()])
+	}
+	return sb.String(), nil
+}
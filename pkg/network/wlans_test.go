@@ -0,0 +1,245 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	pkgerrors "github.com/ilmax/unifi-client-go/pkg/errors"
+)
+
+// TestNetwork_WLANs tests the WiFi broadcast CRUD requests.
+func TestNetwork_WLANs(t *testing.T) {
+	t.Parallel()
+
+	const base = "/proxy/network/integration/v1/sites/site-1/wifi/broadcasts"
+	wlan := WLAN{
+		ID:                    "wlan-1",
+		Name:                  "Home",
+		Enabled:               true,
+		Network:               WLANNetwork{WLANNetworkType: WLANNetworkTypeSpecific, NetworkID: "net-1"},
+		SecurityConfiguration: WLANSecurityConfiguration{WLANSecurityMode: WLANSecurityModeWPA2Personal, Passphrase: "secret-passphrase"},
+	}
+
+	var requests []string
+	var bodies []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		requests = append(requests, r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery)
+		if r.Method == http.MethodPost || r.Method == http.MethodPut {
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode body: %v", err)
+			}
+			bodies = append(bodies, body)
+		}
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET " + base:
+			json.NewEncoder(w).Encode(ListWLANsResponse{Count: 1, TotalCount: 1, Data: []WLAN{wlan}})
+		case "POST " + base, "GET " + base + "/wlan-1", "PUT " + base + "/wlan-1":
+			json.NewEncoder(w).Encode(wlan)
+		case "DELETE " + base + "/wlan-1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	n := newTestNetwork(t, server, Config{APIKey: "key"})
+	ctx := context.Background()
+
+	list, err := n.ListWLANs(ctx, &ListWLANsRequest{SiteID: "site-1", Limit: 10, Filter: "name.eq('Home')"})
+	if err != nil {
+		t.Fatalf("ListWLANs() error = %v", err)
+	}
+	if len(list.Data) != 1 || list.Data[0].SecurityConfiguration.WLANSecurityMode != WLANSecurityModeWPA2Personal {
+		t.Errorf("ListWLANs() = %+v", list)
+	}
+
+	got, err := n.GetWLAN(ctx, &WLANDetailsRequest{SiteID: "site-1", WLANID: "wlan-1"})
+	if err != nil {
+		t.Fatalf("GetWLAN() error = %v", err)
+	}
+	if got.Name != "Home" || got.Network.NetworkID != "net-1" {
+		t.Errorf("GetWLAN() = %+v", got)
+	}
+
+	if _, err := n.CreateWLAN(ctx, &CreateWLANRequest{
+		SiteID:                "site-1",
+		Name:                  "Home",
+		Enabled:               true,
+		Network:               wlan.Network,
+		SecurityConfiguration: wlan.SecurityConfiguration,
+	}); err != nil {
+		t.Fatalf("CreateWLAN() error = %v", err)
+	}
+	if _, err := n.UpdateWLAN(ctx, &UpdateWLANRequest{SiteID: "site-1", WLANID: "wlan-1", Name: "Home 2"}); err != nil {
+		t.Fatalf("UpdateWLAN() error = %v", err)
+	}
+	if err := n.DeleteWLAN(ctx, &DeleteWLANRequest{SiteID: "site-1", WLANID: "wlan-1"}); err != nil {
+		t.Fatalf("DeleteWLAN() error = %v", err)
+	}
+
+	wantRequests := []string{
+		"GET " + base + "?filter=name.eq%28%27Home%27%29&limit=10",
+		"GET " + base + "/wlan-1?",
+		"POST " + base + "?",
+		"PUT " + base + "/wlan-1?",
+		"DELETE " + base + "/wlan-1?",
+	}
+	if !reflect.DeepEqual(requests, wantRequests) {
+		t.Errorf("requests = %q, want %q", requests, wantRequests)
+	}
+
+	if len(bodies) != 2 {
+		t.Fatalf("bodies = %v, want create and update", bodies)
+	}
+	create, update := bodies[0], bodies[1]
+	if create["name"] != "Home" || create["securityConfiguration"].(map[string]interface{})["passphrase"] != "secret-passphrase" {
+		t.Errorf("create body = %v", create)
+	}
+	if _, ok := create["siteId"]; ok {
+		t.Errorf("create body carries the site ID: %v", create)
+	}
+	if update["name"] != "Home 2" || update["wlanId"] != nil || update["id"] != nil {
+		t.Errorf("update body = %v", update)
+	}
+}
+
+// TestNetwork_WLANValidation tests that invalid WLAN requests are rejected before sending.
+func TestNetwork_WLANValidation(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	n := newTestNetwork(t, server, Config{APIKey: "key"})
+	ctx := context.Background()
+
+	if _, err := n.ListWLANs(ctx, nil); !errors.Is(err, pkgerrors.ErrEmptySiteID) {
+		t.Errorf("ListWLANs() error = %v, want ErrEmptySiteID", err)
+	}
+	if _, err := n.GetWLAN(ctx, &WLANDetailsRequest{SiteID: "site-1"}); !errors.Is(err, pkgerrors.ErrEmptyWLANID) {
+		t.Errorf("GetWLAN() error = %v, want ErrEmptyWLANID", err)
+	}
+	if _, err := n.CreateWLAN(ctx, &CreateWLANRequest{SiteID: "site-1"}); !pkgerrors.IsValidationError(err) {
+		t.Errorf("CreateWLAN() error = %v, want validation error", err)
+	}
+	if _, err := n.UpdateWLAN(ctx, &UpdateWLANRequest{SiteID: "site-1"}); !errors.Is(err, pkgerrors.ErrEmptyWLANID) {
+		t.Errorf("UpdateWLAN() error = %v, want ErrEmptyWLANID", err)
+	}
+	if err := n.DeleteWLAN(ctx, &DeleteWLANRequest{WLANID: "wlan-1"}); !errors.Is(err, pkgerrors.ErrEmptySiteID) {
+		t.Errorf("DeleteWLAN() error = %v, want ErrEmptySiteID", err)
+	}
+	if _, err := n.RotateWLANPassphrase(ctx, "site-1", ""); !errors.Is(err, pkgerrors.ErrEmptyWLANID) {
+		t.Errorf("RotateWLANPassphrase() error = %v, want ErrEmptyWLANID", err)
+	}
+}
+
+// TestNetwork_RotateWLANPassphrase tests that rotation keeps every fetched setting and only changes the passphrase.
+func TestNetwork_RotateWLANPassphrase(t *testing.T) {
+	t.Parallel()
+
+	// The broadcast includes settings the SDK does not model, which must survive the full replacement.
+	const fetched = `{
+		"id": "wlan-1",
+		"name": "Home",
+		"enabled": true,
+		"metadata": {"origin": "USER_DEFINED"},
+		"network": {"type": "SPECIFIC", "networkId": "net-1"},
+		"securityConfiguration": {"type": "%s", "passphrase": "old-passphrase", "pmfMode": "OPTIONAL", "fastRoamingEnabled": true},
+		"hideName": true,
+		"bandSteeringEnabled": true,
+		"broadcastingFrequenciesGHz": [2.4, 5],
+		"clientIsolationEnabled": false,
+		"multicastToUnicastConversionEnabled": true,
+		"dtimPeriod": {"2.4": 1, "5": 3},
+		"radiusAccountingSessionId": 12345678901234567891
+	}`
+
+	tests := []struct {
+		name    string
+		mode    WLANSecurityMode
+		wantPut bool
+	}{
+		{name: "WPA2 personal", mode: WLANSecurityModeWPA2Personal, wantPut: true},
+		{name: "WPA2/WPA3 personal", mode: WLANSecurityModeWPA2WPA3Personal, wantPut: true},
+		{name: "open network is rejected", mode: WLANSecurityModeOpen},
+		{name: "enterprise network is rejected", mode: WLANSecurityModeWPA2Enterprise},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			body := []byte(fmt.Sprintf(fetched, tt.mode))
+			var put map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method + " " + r.URL.Path {
+				case "GET /":
+					w.WriteHeader(http.StatusOK)
+				case "GET /proxy/network/integration/v1/sites/site-1/wifi/broadcasts/wlan-1":
+					w.Write(body)
+				case "PUT /proxy/network/integration/v1/sites/site-1/wifi/broadcasts/wlan-1":
+					dec := json.NewDecoder(r.Body)
+					dec.UseNumber()
+					if err := dec.Decode(&put); err != nil {
+						t.Errorf("failed to decode body: %v", err)
+					}
+					w.Write(body)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			n := newTestNetwork(t, server, Config{APIKey: "key"})
+
+			passphrase, err := n.RotateWLANPassphrase(context.Background(), "site-1", "wlan-1")
+			if !tt.wantPut {
+				if !pkgerrors.IsValidationError(err) {
+					t.Errorf("error = %v, want validation error", err)
+				}
+				if put != nil {
+					t.Errorf("PUT sent for %s broadcast: %v", tt.mode, put)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RotateWLANPassphrase() error = %v", err)
+			}
+			if len(passphrase) != DefaultPassphraseLength || passphrase == "old-passphrase" {
+				t.Errorf("passphrase = %q", passphrase)
+			}
+
+			// Numbers are compared as written, so precision lost in a float64 round trip is detected.
+			var want map[string]interface{}
+			dec := json.NewDecoder(bytes.NewReader(body))
+			dec.UseNumber()
+			if err := dec.Decode(&want); err != nil {
+				t.Fatal(err)
+			}
+			delete(want, "id")
+			delete(want, "metadata")
+			want["securityConfiguration"].(map[string]interface{})["passphrase"] = passphrase
+
+			if !reflect.DeepEqual(put, want) {
+				t.Errorf("PUT body = %v\nwant %v", put, want)
+			}
+		})
+	}
+}
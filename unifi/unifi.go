@@ -2,15 +2,19 @@
 package unifi
 
 import (
+	"log/slog"
+	"time"
+
 	"github.com/ilmax/unifi-client-go/pkg/config"
 	"github.com/ilmax/unifi-client-go/pkg/errors"
+	"github.com/ilmax/unifi-client-go/pkg/middleware"
 	"github.com/ilmax/unifi-client-go/pkg/network"
 	"github.com/ilmax/unifi-client-go/pkg/sitemanager"
 )
 
 // UniFi is a collection of UniFi APIs.
 // Use New() for Site Manager API (cloud API with API key).
-// Use NewNetwork() for Network API (local controller with username/password).
+// Use NewNetwork() for Network API (local controller with API key or username/password).
 type UniFi struct {
 	SiteManager *sitemanager.SiteManager
 
@@ -40,6 +44,7 @@ func New(opts ...ConfigOption) (*UniFi, error) {
 
 // NewNetwork creates a new Network API client for local UniFi controllers.
 // Use this for UDM, Cloud Key, or software-based controllers.
+// Set network.Config.APIKey to authenticate without a username/password session.
 func NewNetwork(cfg network.Config) (*network.Network, error) {
 	return network.New(cfg)
 }
@@ -66,3 +71,34 @@ func ConfigTimeout(timeout config.ConfigOption) ConfigOption {
 func ConfigUserAgent(userAgent string) ConfigOption {
 	return config.ConfigUserAgent(userAgent)
 }
+
+// ConfigRetry enables retries of requests failing with 429, 502, 503, 504 or a transient network error.
+func ConfigRetry(maxAttempts int) ConfigOption {
+	return config.ConfigRetry(maxAttempts)
+}
+
+// ConfigRetryBackoff sets the delay before the first retry and the maximum delay between retries.
+func ConfigRetryBackoff(baseDelay, maxDelay time.Duration) ConfigOption {
+	return config.ConfigRetryBackoff(baseDelay, maxDelay)
+}
+
+// ConfigRetryPOST allows retrying POST requests.
+func ConfigRetryPOST() ConfigOption {
+	return config.ConfigRetryPOST()
+}
+
+// ConfigRateLimit limits Site Manager requests to requestsPerSecond on average, allowing bursts of up to burst requests.
+// The limit is shared by every call made through the same UniFi instance.
+func ConfigRateLimit(requestsPerSecond float64, burst int) ConfigOption {
+	return config.ConfigRateLimit(requestsPerSecond, burst)
+}
+
+// ConfigMiddleware adds middlewares wrapping every Site Manager request, such as middleware.Logging or middleware.Headers.
+func ConfigMiddleware(middlewares ...middleware.Middleware) ConfigOption {
+	return config.ConfigMiddleware(middlewares...)
+}
+
+// ConfigLogger logs every Site Manager request attempt at debug level, with secrets redacted.
+func ConfigLogger(logger *slog.Logger) ConfigOption {
+	return config.ConfigLogger(logger)
+}